@@ -28,12 +28,18 @@ func init() {
 	serverCmd.PersistentFlags().Int("port", 8080, "port to run the Seastat server on (for Prometheus to scrape)")
 	serverCmd.PersistentFlags().Duration("timeout", 3*time.Second, "how long before we timeout a Jolokia request")
 	serverCmd.PersistentFlags().Int("concurrency", 10, "maximum number of concurrent requests to Jolokia")
+	serverCmd.PersistentFlags().Bool("consistency-levels", false, "export client request metrics per consistency level (Cassandra 4.0+)")
+	serverCmd.PersistentFlags().Bool("client-connections", false, "export connected clients by user, driver and protocol version")
+	serverCmd.PersistentFlags().Int("client-connections-top-n", 20, "maximum number of users, drivers or protocol versions exported for connected clients (0 for no limit)")
 
 	viper.BindPFlag("endpoint", serverCmd.PersistentFlags().Lookup("endpoint"))
 	viper.BindPFlag("interval", serverCmd.PersistentFlags().Lookup("interval"))
 	viper.BindPFlag("port", serverCmd.PersistentFlags().Lookup("port"))
 	viper.BindPFlag("timeout", serverCmd.PersistentFlags().Lookup("timeout"))
 	viper.BindPFlag("concurrency", serverCmd.PersistentFlags().Lookup("concurrency"))
+	viper.BindPFlag("consistency-levels", serverCmd.PersistentFlags().Lookup("consistency-levels"))
+	viper.BindPFlag("client-connections", serverCmd.PersistentFlags().Lookup("client-connections"))
+	viper.BindPFlag("client-connections-top-n", serverCmd.PersistentFlags().Lookup("client-connections-top-n"))
 }
 
 func run(cmd *cobra.Command) {
@@ -42,6 +48,9 @@ func run(cmd *cobra.Command) {
 	port := viper.GetInt("port")
 	timeout := viper.GetDuration("timeout")
 	concurrency := viper.GetInt("concurrency")
+	consistencyLevels := viper.GetBool("consistency-levels")
+	clientConnections := viper.GetBool("client-connections")
+	clientConnectionsTopN := viper.GetInt("client-connections-top-n")
 
 	if endpoint == "" {
 		logrus.Fatalf("'endpoint' can not be empty")
@@ -63,5 +72,9 @@ func run(cmd *cobra.Command) {
 		logrus.Fatalf("could not connect to Jolokia: %v", err)
 	}
 	logrus.Infof("☕ Communicating with Jolokia %s (%s)", version, endpoint)
-	server.Run(client, interval, port, concurrency)
+	server.Run(client, interval, port, concurrency, server.ScrapeOptions{
+		ConsistencyLevels:     consistencyLevels,
+		ClientConnections:     clientConnections,
+		ClientConnectionsTopN: clientConnectionsTopN,
+	})
 }
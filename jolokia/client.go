@@ -9,7 +9,9 @@ import (
 	"net/url"
 	"path"
 	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/valyala/fastjson"
@@ -18,6 +20,11 @@ import (
 type jolokiaClient struct {
 	endpoint   string
 	httpClient *http.Client
+
+	// releaseMajor is the major version of Cassandra, it is detected each
+	// time the tables are refreshed and is zero if it couldn't be read
+	mu           sync.RWMutex
+	releaseMajor int
 }
 
 // Init initializes and returns a Client ready for calls. The endpoint should
@@ -63,9 +70,32 @@ func (c *jolokiaClient) Tables() ([]Table, error) {
 			tables = append(tables, Table{KeyspaceName: keyspace, TableName: table})
 		}
 	})
+
+	// Cassandra may have been upgraded since we last looked so we take the
+	// chance to detect the version again
+	c.detectReleaseVersion()
 	return tables, nil
 }
 
+// detectReleaseVersion reads the release version of Cassandra and keeps its
+// major version so we know which mbeans to read. This is best effort, if we
+// can't read it we keep the version we already had
+func (c *jolokiaClient) detectReleaseVersion() {
+	v, err := c.read("org.apache.cassandra.db", "type=StorageService/ReleaseVersion")
+	if err != nil {
+		return
+	}
+
+	major, err := strconv.Atoi(strings.SplitN(string(v.Get("value").GetStringBytes()), ".", 2)[0])
+	if err != nil {
+		return
+	}
+
+	c.mu.Lock()
+	c.releaseMajor = major
+	c.mu.Unlock()
+}
+
 // TableStats gets all the stats for a given Table within Cassandra
 func (c *jolokiaClient) TableStats(table Table) (TableStats, error) {
 	metricItems := []string{
@@ -77,6 +107,8 @@ func (c *jolokiaClient) TableStats(table Table) (TableStats, error) {
 		"RangeLatency",
 		"CasProposeLatency",
 		"CasCommitLatency",
+		"ViewLockAcquireTime",
+		"ViewReadTime",
 
 		"EstimatedPartitionCount",
 		"PendingCompactions",
@@ -94,6 +126,42 @@ func (c *jolokiaClient) TableStats(table Table) (TableStats, error) {
 		"SpeculativeRetries",
 		"SpeculativeFailedRetries",
 		"CompressionRatio",
+		"ReadRepairRequests",
+		"ShortReadProtectionRequests",
+		"DroppedMutations",
+		"RowCacheHit",
+		"RowCacheMiss",
+		"RowCacheHitOutOfRange",
+		"TombstoneWarnings",
+		"TombstoneFailures",
+
+		"BytesRepaired",
+		"BytesUnrepaired",
+		"BytesPendingRepair",
+		"RepairedDataInconsistenciesConfirmed",
+		"RepairedDataInconsistenciesUnconfirmed",
+		"AnticompactionTime",
+		"ValidationTime",
+		"RepairSyncTime",
+
+		"MemtableLiveDataSize",
+		"MemtableOnHeapSize",
+		"MemtableOffHeapSize",
+		"MemtableColumnsCount",
+		"MemtableSwitchCount",
+		"PendingFlushes",
+		"AllMemtablesLiveDataSize",
+		"AllMemtablesHeapSize",   // Cassandra 3.x
+		"AllMemtablesOnHeapSize", // Cassandra 4.0+
+		"AllMemtablesOffHeapSize",
+
+		"BloomFilterOffHeapMemoryUsed",
+		"IndexSummaryOffHeapMemoryUsed",
+		"CompressionMetadataOffHeapMemoryUsed",
+		"BloomFilterDiskSpaceUsed",
+
+		"EstimatedPartitionSizeHistogram",
+		"EstimatedColumnCountHistogram",
 	}
 
 	mbeanGroups := make([][]string, 0, len(metricItems))
@@ -137,6 +205,10 @@ func (c *jolokiaClient) TableStats(table Table) (TableStats, error) {
 			stats.CASProposeLatency = parseLatency(val)
 		case "CasCommitLatency":
 			stats.CASCommitLatency = parseLatency(val)
+		case "ViewLockAcquireTime":
+			stats.ViewLockAcquireTime = parseLatency(val)
+		case "ViewReadTime":
+			stats.ViewReadTime = parseLatency(val)
 
 		// Table specific stats
 		case "EstimatedPartitionCount":
@@ -171,11 +243,495 @@ func (c *jolokiaClient) TableStats(table Table) (TableStats, error) {
 			stats.SpeculativeFailedRetries = Counter(val.Get("Count").GetInt64())
 		case "CompressionRatio":
 			stats.CompressionRatio = FloatGauge(val.Get("Value").GetFloat64())
+		case "ReadRepairRequests":
+			stats.ReadRepairRequests = Counter(val.Get("Count").GetInt64())
+		case "ShortReadProtectionRequests":
+			stats.ShortReadProtectionRequests = Counter(val.Get("Count").GetInt64())
+		case "DroppedMutations":
+			stats.DroppedMutations = Counter(val.Get("Count").GetInt64())
+		case "RowCacheHit":
+			stats.RowCacheHits = Counter(val.Get("Count").GetInt64())
+		case "RowCacheMiss":
+			stats.RowCacheMisses = Counter(val.Get("Count").GetInt64())
+		case "RowCacheHitOutOfRange":
+			stats.RowCacheHitsOutOfRange = Counter(val.Get("Count").GetInt64())
+		case "TombstoneWarnings":
+			stats.TombstoneWarnings = Counter(val.Get("Count").GetInt64())
+		case "TombstoneFailures":
+			stats.TombstoneFailures = Counter(val.Get("Count").GetInt64())
+
+		// Repair stats
+		case "BytesRepaired":
+			stats.BytesRepaired = BytesGauge(val.Get("Value").GetInt64())
+		case "BytesUnrepaired":
+			stats.BytesUnrepaired = BytesGauge(val.Get("Value").GetInt64())
+		case "BytesPendingRepair":
+			stats.BytesPendingRepair = BytesGauge(val.Get("Value").GetInt64())
+		case "RepairedDataInconsistenciesConfirmed":
+			stats.RepairedDataInconsistenciesConfirmed = Counter(val.Get("Count").GetInt64())
+		case "RepairedDataInconsistenciesUnconfirmed":
+			stats.RepairedDataInconsistenciesUnconfirmed = Counter(val.Get("Count").GetInt64())
+		case "AnticompactionTime":
+			stats.AnticompactionTime = parseLatency(val)
+		case "ValidationTime":
+			stats.ValidationTime = parseLatency(val)
+		case "RepairSyncTime":
+			stats.RepairSyncTime = parseLatency(val)
+
+		// Memtable stats
+		case "MemtableLiveDataSize":
+			stats.MemtableLiveDataSize = BytesGauge(val.Get("Value").GetInt64())
+		case "MemtableOnHeapSize":
+			stats.MemtableOnHeapSize = BytesGauge(val.Get("Value").GetInt64())
+		case "MemtableOffHeapSize":
+			stats.MemtableOffHeapSize = BytesGauge(val.Get("Value").GetInt64())
+		case "MemtableColumnsCount":
+			stats.MemtableColumnsCount = Gauge(val.Get("Value").GetInt64())
+		case "MemtableSwitchCount":
+			stats.MemtableSwitchCount = Counter(val.Get("Count").GetInt64())
+		case "PendingFlushes":
+			stats.PendingFlushes = Gauge(val.Get("Count").GetInt64())
+		case "AllMemtablesLiveDataSize":
+			stats.AllMemtablesLiveDataSize = BytesGauge(val.Get("Value").GetInt64())
+		case "AllMemtablesHeapSize", "AllMemtablesOnHeapSize":
+			stats.AllMemtablesOnHeapSize = BytesGauge(val.Get("Value").GetInt64())
+		case "AllMemtablesOffHeapSize":
+			stats.AllMemtablesOffHeapSize = BytesGauge(val.Get("Value").GetInt64())
+
+		// Off-heap memory stats
+		case "BloomFilterOffHeapMemoryUsed":
+			stats.BloomFilterOffHeapMemoryUsed = BytesGauge(val.Get("Value").GetInt64())
+		case "IndexSummaryOffHeapMemoryUsed":
+			stats.IndexSummaryOffHeapMemoryUsed = BytesGauge(val.Get("Value").GetInt64())
+		case "CompressionMetadataOffHeapMemoryUsed":
+			stats.CompressionMetadataOffHeapMemoryUsed = BytesGauge(val.Get("Value").GetInt64())
+		case "BloomFilterDiskSpaceUsed":
+			stats.BloomFilterDiskSpaceUsed = BytesGauge(val.Get("Value").GetInt64())
+
+		// Distributions across all SSTables
+		case "EstimatedPartitionSizeHistogram":
+			stats.PartitionSizes = parseBucketedHistogram(val.Get("Value"))
+		case "EstimatedColumnCountHistogram":
+			stats.CellCounts = parseBucketedHistogram(val.Get("Value"))
+		}
+	}
+
+	return stats, nil
+}
+
+// SSTableLayout gets how the SSTables of a given Table are spread across
+// levels (for LeveledCompactionStrategy) or time windows (for
+// TimeWindowCompactionStrategy). These aren't exposed as metrics so they are
+// read from the table's ColumnFamilyStore instead
+func (c *jolokiaClient) SSTableLayout(table Table) (SSTableLayout, error) {
+	store := c.tableStoreAttributes(table, []string{
+		"SSTableCountPerLevel",
+		"SSTableCountPerTWCSBucket", // Cassandra 4.1+
+	})
+	if len(store) == 0 {
+		return SSTableLayout{}, fmt.Errorf("err reading sstable layout")
+	}
+
+	layout := SSTableLayout{}
+	for _, level := range store["SSTableCountPerLevel"].GetArray() {
+		layout.PerLevel = append(layout.PerLevel, Gauge(level.GetInt64()))
+	}
+	layout.PerWindow = parseSSTablesPerWindow(store["SSTableCountPerTWCSBucket"])
+	return layout, nil
+}
+
+// TableConfig gets the configuration of a given Table within Cassandra such
+// as its compaction strategy and compression. These are read from the
+// table's ColumnFamilyStore and only change on ALTER or compaction
+func (c *jolokiaClient) TableConfig(table Table) (TableConfig, error) {
+	store := c.tableStoreAttributes(table, []string{
+		"CompactionParameters",
+		"CompressionParameters",
+		"MinimumCompactionThreshold",
+		"MaximumCompactionThreshold",
+		"DroppableTombstoneRatio",
+	})
+
+	compaction, ok := store["CompactionParameters"]
+	if !ok {
+		return TableConfig{}, fmt.Errorf("err reading compaction parameters")
+	}
+
+	config := TableConfig{Table: table}
+	params := valueObjectToStringMap(compaction.GetObject())
+	config.CompactionStrategy = shortClassName(params["class"])
+	if compression, ok := store["CompressionParameters"]; ok {
+		params := valueObjectToStringMap(compression.GetObject())
+		config.Compression = parseCompression(params)
+		if chunkLength, err := strconv.ParseInt(params["chunk_length_in_kb"], 10, 64); err == nil {
+			config.CompressionChunkLength = BytesGauge(chunkLength * 1024)
+		}
+	}
+	config.MinCompactionThreshold = Gauge(store["MinimumCompactionThreshold"].GetInt64())
+	config.MaxCompactionThreshold = Gauge(store["MaximumCompactionThreshold"].GetInt64())
+	config.DroppableTombstoneRatio = FloatGauge(store["DroppableTombstoneRatio"].GetFloat64())
+	return config, nil
+}
+
+// Views works out which of the given tables are materialized views. Views
+// aren't told apart from tables in their metrics so we instead look at the
+// view build status which Cassandra records for every view (and only views).
+// Asking for the build status is a distributed read so callers should only
+// ask about tables they haven't asked about before. Tables whose build
+// status couldn't be read are missing from the result
+func (c *jolokiaClient) Views(tables []Table) (map[Table]bool, error) {
+	if len(tables) == 0 {
+		return map[Table]bool{}, nil
+	}
+
+	arguments := make([][]interface{}, 0, len(tables))
+	for _, table := range tables {
+		arguments = append(arguments, []interface{}{table.KeyspaceName, table.TableName})
+	}
+
+	v, err := c.bulkExec("org.apache.cassandra.db", []string{"type=StorageService"}, "getViewBuildStatuses", arguments)
+	if err != nil {
+		return nil, fmt.Errorf("err reading view build statuses: %v", err)
+	}
+
+	views := make(map[Table]bool, len(tables))
+	for idx, item := range v.GetArray() {
+		if idx >= len(tables) || item.Get("status").GetInt64() != http.StatusOK {
+			continue
+		}
+		obj := item.GetObject("value")
+		views[tables[idx]] = obj != nil && obj.Len() > 0
+	}
+	return views, nil
+}
+
+// Indexes gets the list of secondary indexes from Cassandra. Legacy secondary
+// indexes are backed by a hidden table whose metrics are registered with the
+// scope <table>.<index> whereas storage-attached indexes have their own type
+func (c *jolokiaClient) Indexes() ([]Index, error) {
+	indexes := []Index{}
+	seen := map[Index]struct{}{}
+
+	searches := [][]string{
+		{"type=IndexTable", "name=LiveDiskSpaceUsed", "*"},        // Cassandra 4.0+
+		{"type=IndexColumnFamily", "name=LiveDiskSpaceUsed", "*"}, // Cassandra 3.x
+		{"type=StorageAttachedIndex", "scope=IndexMetrics", "name=DiskUsedBytes", "*"},
+	}
+	for _, search := range searches {
+		v, err := c.search("org.apache.cassandra.metrics", search...)
+		if err != nil {
+			return nil, fmt.Errorf("err searching indexes: %v", err)
+		}
+
+		for _, mbean := range v.GetArray("value") {
+			attributes := extractAttributes(string(mbean.GetStringBytes()))
+
+			index := Index{Table: Table{KeyspaceName: attributes["keyspace"]}}
+			if attributes["type"] == "StorageAttachedIndex" {
+				index.Table.TableName = attributes["table"]
+				index.IndexName = attributes["index"]
+				index.Type = IndexTypeSAI
+			} else {
+				parts := strings.SplitN(attributes["scope"], ".", 2)
+				if len(parts) != 2 {
+					continue
+				}
+				index.Table.TableName = parts[0]
+				index.IndexName = parts[1]
+				index.Type = IndexTypeSecondary
+			}
+
+			if index.Table.KeyspaceName == "" || index.Table.TableName == "" || index.IndexName == "" {
+				continue
+			}
+			if _, ok := seen[index]; ok {
+				continue
+			}
+			seen[index] = struct{}{}
+			indexes = append(indexes, index)
+		}
+	}
+	return indexes, nil
+}
+
+// IndexStats gets all the stats for a given Index within Cassandra
+func (c *jolokiaClient) IndexStats(index Index) (IndexStats, error) {
+	// Whether the index has been built is kept by the base table. This is
+	// best effort, if we can't read it we still want the rest of the stats
+	stats := IndexStats{Index: index}
+	store := c.tableStoreAttributes(index.Table, []string{"BuiltIndexes"})
+	if builtIndexes, ok := store["BuiltIndexes"]; ok {
+		built := false
+		for _, name := range builtIndexes.GetArray() {
+			if string(name.GetStringBytes()) == index.IndexName {
+				built = true
+			}
+		}
+		stats.Built = &built
+	}
+
+	var mbeanGroups [][]string
+	switch index.Type {
+	case IndexTypeSAI:
+		for _, scope := range []struct {
+			name    string
+			metrics []string
+		}{
+			{name: "IndexMetrics", metrics: []string{
+				"DiskUsedBytes",
+				"MemtableIndexBytes",
+				"SSTableCellCount",
+				"LiveMemtableIndexWriteCount",
+				"MemtableIndexWriteLatency",
+				"MemtableIndexFlushCount",
+				"CompactionCount",
+				"SegmentsPerCompaction",
+			}},
+			// Only one of these exists for an index depending on whether
+			// it's a string (trie) or numeric (KD-tree) index
+			{name: "ColumnQueryMetrics", metrics: []string{
+				"TermsLookupLatency",
+				"KDTreeIntersectionLatency",
+			}},
+		} {
+			for _, name := range scope.metrics {
+				mbeanGroups = append(mbeanGroups, []string{
+					"type=StorageAttachedIndex",
+					fmt.Sprintf("keyspace=%s", index.Table.KeyspaceName),
+					fmt.Sprintf("table=%s", index.Table.TableName),
+					fmt.Sprintf("index=%s", index.IndexName),
+					fmt.Sprintf("scope=%s", scope.name),
+					fmt.Sprintf("name=%s", name),
+				})
+			}
+		}
+	default:
+		// The type of the hidden index table depends on the version of
+		// Cassandra so we ask for both
+		for _, mbeanType := range []string{"IndexTable", "IndexColumnFamily"} {
+			for _, name := range []string{
+				"LiveDiskSpaceUsed",
+				"TotalDiskSpaceUsed",
+				"LiveSSTableCount",
+				"MemtableLiveDataSize",
+				"ReadLatency",
+			} {
+				mbeanGroups = append(mbeanGroups, []string{
+					fmt.Sprintf("type=%s", mbeanType),
+					fmt.Sprintf("keyspace=%s", index.Table.KeyspaceName),
+					fmt.Sprintf("scope=%s.%s", index.Table.TableName, index.IndexName),
+					fmt.Sprintf("name=%s", name),
+				})
+			}
+		}
+	}
+
+	v, err := c.bulkRequest("org.apache.cassandra.metrics", mbeanGroups, [][]string{})
+	if err != nil {
+		return IndexStats{}, fmt.Errorf("err reading index: %v", err)
+	}
+
+	for _, item := range v.GetArray() {
+		if item.Get("status").GetInt64() != http.StatusOK {
+			continue
+		}
+
+		attributes := extractAttributes(string(item.Get("request", "mbean").GetStringBytes()))
+		val := item.Get("value")
+		switch attributes["name"] {
+		// Common stats
+		case "LiveDiskSpaceUsed":
+			stats.LiveDiskSpaceUsed = BytesGauge(val.Get("Count").GetInt64())
+		case "DiskUsedBytes":
+			stats.LiveDiskSpaceUsed = BytesGauge(val.Get("Value").GetInt64())
+		case "MemtableLiveDataSize", "MemtableIndexBytes":
+			stats.MemtableSize = BytesGauge(val.Get("Value").GetInt64())
+		case "ReadLatency", "TermsLookupLatency", "KDTreeIntersectionLatency":
+			stats.QueryLatency = parseLatency(val)
+
+		// Legacy secondary index stats
+		case "TotalDiskSpaceUsed":
+			stats.TotalDiskSpaceUsed = BytesGauge(val.Get("Count").GetInt64())
+		case "LiveSSTableCount":
+			stats.LiveSSTables = Gauge(val.Get("Value").GetInt64())
+
+		// Storage-attached index stats
+		case "SSTableCellCount":
+			stats.SSTableCells = Gauge(val.Get("Value").GetInt64())
+		case "LiveMemtableIndexWriteCount":
+			stats.MemtableIndexWrites = Gauge(val.Get("Value").GetInt64())
+		case "MemtableIndexWriteLatency":
+			stats.MemtableIndexWriteLatency = parseLatency(val)
+		case "MemtableIndexFlushCount":
+			stats.MemtableIndexFlushes = Counter(val.Get("Count").GetInt64())
+		case "CompactionCount":
+			stats.Compactions = Counter(val.Get("Count").GetInt64())
+		case "SegmentsPerCompaction":
+			stats.SegmentsPerCompaction = parseHistogram(val)
 		}
 	}
 	return stats, nil
 }
 
+// KeyspaceStats gets the stats for each keyspace which are aggregated across
+// all the tables in the keyspace by Cassandra
+func (c *jolokiaClient) KeyspaceStats() ([]KeyspaceStats, error) {
+	metricItems := []string{
+		"ReadLatency",
+		"WriteLatency",
+		"RangeLatency",
+		"LiveDiskSpaceUsed",
+		"TotalDiskSpaceUsed",
+		"PendingCompactions",
+		"PendingFlushes",
+	}
+
+	// We ask for each metric across all keyspaces rather than all metrics for
+	// each keyspace because there are far fewer metrics we care about than
+	// keyspace metrics that exist
+	mbeanGroups := make([][]string, 0, len(metricItems))
+	for _, name := range metricItems {
+		mbeanGroups = append(mbeanGroups, []string{
+			"type=Keyspace",
+			"keyspace=*",
+			fmt.Sprintf("name=%s", name),
+		})
+	}
+
+	v, err := c.bulkRequest("org.apache.cassandra.metrics", mbeanGroups, [][]string{})
+	if err != nil {
+		return []KeyspaceStats{}, fmt.Errorf("err reading keyspace stats: %v", err)
+	}
+	return parseKeyspaceStats(v), nil
+}
+
+// parseKeyspaceStats groups the metrics of a bulk keyspace request by
+// keyspace and returns them sorted by keyspace name
+func parseKeyspaceStats(v *fastjson.Value) []KeyspaceStats {
+	stats := map[string]*KeyspaceStats{}
+	for _, item := range v.GetArray() {
+		if item.Get("status").GetInt64() != http.StatusOK {
+			continue
+		}
+
+		item.Get("value").GetObject().Visit(func(key []byte, val *fastjson.Value) {
+			attributes := extractAttributes(string(key))
+			keyspace := attributes["keyspace"]
+			if keyspace == "" {
+				return
+			}
+
+			stat, ok := stats[keyspace]
+			if !ok {
+				stat = &KeyspaceStats{KeyspaceName: keyspace}
+				stats[keyspace] = stat
+			}
+
+			switch attributes["name"] {
+			case "ReadLatency":
+				stat.ReadLatency = parseLatency(val)
+			case "WriteLatency":
+				stat.WriteLatency = parseLatency(val)
+			case "RangeLatency":
+				stat.RangeLatency = parseLatency(val)
+			case "LiveDiskSpaceUsed":
+				stat.LiveDiskSpaceUsed = BytesGauge(val.Get("Value").GetInt64())
+			case "TotalDiskSpaceUsed":
+				stat.TotalDiskSpaceUsed = BytesGauge(val.Get("Value").GetInt64())
+			case "PendingCompactions":
+				stat.PendingCompactions = Gauge(val.Get("Value").GetInt64())
+			case "PendingFlushes":
+				stat.PendingFlushes = Gauge(val.Get("Count").GetInt64())
+			}
+		})
+	}
+
+	names := make([]string, 0, len(stats))
+	for keyspace := range stats {
+		names = append(names, keyspace)
+	}
+	sort.Strings(names)
+
+	out := make([]KeyspaceStats, 0, len(names))
+	for _, keyspace := range names {
+		out = append(out, *stats[keyspace])
+	}
+	return out
+}
+
+// tableStoreAttributes reads the given attributes from the ColumnFamilyStore
+// mbean of a table. Each attribute is read as a separate request so that an
+// attribute which doesn't exist on this version of Cassandra doesn't fail the
+// others. Attributes which couldn't be read are missing from the result
+func (c *jolokiaClient) tableStoreAttributes(table Table, attributes []string) map[string]*fastjson.Value {
+	// The mbean is registered as type=Tables in Cassandra 4.0+ and as
+	// type=ColumnFamilies in Cassandra 3.x. If we don't know the version yet
+	// we ask for both
+	tablesMBean := []string{"type=Tables", fmt.Sprintf("keyspace=%s", table.KeyspaceName), fmt.Sprintf("table=%s", table.TableName)}
+	columnFamiliesMBean := []string{"type=ColumnFamilies", fmt.Sprintf("keyspace=%s", table.KeyspaceName), fmt.Sprintf("columnfamily=%s", table.TableName)}
+
+	c.mu.RLock()
+	releaseMajor := c.releaseMajor
+	c.mu.RUnlock()
+
+	var mbeans [][]string
+	switch {
+	case releaseMajor >= 4:
+		mbeans = [][]string{tablesMBean}
+	case releaseMajor > 0:
+		mbeans = [][]string{columnFamiliesMBean}
+	default:
+		mbeans = [][]string{tablesMBean, columnFamiliesMBean}
+	}
+
+	mbeanGroups := make([][]string, 0, len(mbeans)*len(attributes))
+	attributeGroups := make([][]string, 0, len(mbeans)*len(attributes))
+	for _, mbean := range mbeans {
+		for _, attribute := range attributes {
+			mbeanGroups = append(mbeanGroups, mbean)
+			attributeGroups = append(attributeGroups, []string{attribute})
+		}
+	}
+
+	out := map[string]*fastjson.Value{}
+	v, err := c.bulkRequest("org.apache.cassandra.db", mbeanGroups, attributeGroups)
+	if err != nil {
+		return out
+	}
+
+	for _, item := range v.GetArray() {
+		if item.Get("status").GetInt64() != http.StatusOK {
+			continue
+		}
+		for _, attribute := range item.Get("request", "attribute").GetArray() {
+			name := string(attribute.GetStringBytes())
+			out[name] = item.Get("value", name)
+		}
+	}
+	return out
+}
+
+// MemtablePoolStats returns info about how often writes have had to wait for
+// memtable space to be freed up
+func (c *jolokiaClient) MemtablePoolStats() (MemtablePoolStats, error) {
+	v, err := c.read("org.apache.cassandra.metrics", "type=MemtablePool", "name=*")
+	if err != nil {
+		return MemtablePoolStats{}, fmt.Errorf("err reading memtable pool stats: %v", err)
+	}
+
+	stats := MemtablePoolStats{}
+	v.Get("value").GetObject().Visit(func(key []byte, val *fastjson.Value) {
+		attributes := extractAttributes(string(key))
+		switch attributes["name"] {
+		case "BlockedOnAllocation":
+			stats.BlockedOnAllocation = Counter(val.Get("Count").GetInt64())
+		case "WaitingOnFreeMemtableSpace":
+			stats.WaitingOnFreeMemtableSpace = parseLatency(val)
+		}
+	})
+	return stats, nil
+}
+
 // CQLStats returns info about the kinds of CQL statements being processed and
 // how many were prepared vs non-prepared. It also gives some insight into the
 // Prepared Statement cache
@@ -204,6 +760,29 @@ func (c *jolokiaClient) CQLStats() (CQLStats, error) {
 	return stats, nil
 }
 
+// BatchStats returns info about how many partitions are touched by each kind
+// of batch statement
+func (c *jolokiaClient) BatchStats() (BatchStats, error) {
+	v, err := c.read("org.apache.cassandra.metrics", "type=Batch", "name=*")
+	if err != nil {
+		return BatchStats{}, fmt.Errorf("err reading batch stats: %v", err)
+	}
+
+	stats := BatchStats{}
+	v.Get("value").GetObject().Visit(func(key []byte, val *fastjson.Value) {
+		attributes := extractAttributes(string(key))
+		switch attributes["name"] {
+		case "PartitionsPerLoggedBatch":
+			stats.PartitionsPerLoggedBatch = parseHistogram(val)
+		case "PartitionsPerUnloggedBatch":
+			stats.PartitionsPerUnloggedBatch = parseHistogram(val)
+		case "PartitionsPerCounterBatch":
+			stats.PartitionsPerCounterBatch = parseHistogram(val)
+		}
+	})
+	return stats, nil
+}
+
 // ThreadPoolStats returns info about each of the Thread Pools running
 // in Cassandra
 func (c *jolokiaClient) ThreadPoolStats() ([]ThreadPoolStats, error) {
@@ -307,6 +886,84 @@ func (c *jolokiaClient) CompactionStats() (CompactionStats, error) {
 	return stats, nil
 }
 
+// ActiveCompactions returns info about the compactions (and other compaction
+// manager tasks) which are currently running in Cassandra
+func (c *jolokiaClient) ActiveCompactions() ([]ActiveCompaction, error) {
+	v, err := c.read("org.apache.cassandra.db", "type=CompactionManager/Compactions")
+	if err != nil {
+		return []ActiveCompaction{}, fmt.Errorf("err reading active compactions: %v", err)
+	}
+
+	// Each running compaction is a map of strings (even for the numbers) so
+	// we parse them and group them by table and type
+	type compactionKey struct {
+		table    Table
+		taskType string
+		unit     string
+	}
+
+	compactions := map[compactionKey]*ActiveCompaction{}
+	for _, item := range v.GetArray("value") {
+		key := compactionKey{
+			table: Table{
+				KeyspaceName: string(item.Get("keyspace").GetStringBytes()),
+				TableName:    string(item.Get("columnfamily").GetStringBytes()),
+			},
+			taskType: string(item.Get("taskType").GetStringBytes()),
+			unit:     strings.ToLower(string(item.Get("unit").GetStringBytes())),
+		}
+
+		compaction, ok := compactions[key]
+		if !ok {
+			compaction = &ActiveCompaction{Table: key.table, TaskType: key.taskType, Unit: key.unit}
+			compactions[key] = compaction
+		}
+
+		completed, _ := strconv.ParseInt(string(item.Get("completed").GetStringBytes()), 10, 64)
+		total, _ := strconv.ParseInt(string(item.Get("total").GetStringBytes()), 10, 64)
+		compaction.Running++
+		compaction.Completed += Gauge(completed)
+		compaction.Total += Gauge(total)
+	}
+
+	out := make([]ActiveCompaction, 0, len(compactions))
+	for _, compaction := range compactions {
+		out = append(out, *compaction)
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		a := fmt.Sprintf("%s.%s/%s/%s", out[i].Table.KeyspaceName, out[i].Table.TableName, out[i].TaskType, out[i].Unit)
+		b := fmt.Sprintf("%s.%s/%s/%s", out[j].Table.KeyspaceName, out[j].Table.TableName, out[j].TaskType, out[j].Unit)
+		return a < b
+	})
+	return out, nil
+}
+
+// CompactionHistory returns the compactions which have completed recently in
+// Cassandra (as kept in the compaction history)
+func (c *jolokiaClient) CompactionHistory() ([]CompactionHistoryEntry, error) {
+	v, err := c.read("org.apache.cassandra.db", "type=CompactionManager/CompactionHistory")
+	if err != nil {
+		return []CompactionHistoryEntry{}, fmt.Errorf("err reading compaction history: %v", err)
+	}
+
+	rows := tabularRows(v.Get("value"), "id")
+	out := make([]CompactionHistoryEntry, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, CompactionHistoryEntry{
+			ID: string(row.Get("id").GetStringBytes()),
+			Table: Table{
+				KeyspaceName: string(row.Get("keyspace_name").GetStringBytes()),
+				TableName:    string(row.Get("columnfamily_name").GetStringBytes()),
+			},
+			CompactedAt: time.Unix(0, row.Get("compacted_at").GetInt64()*int64(time.Millisecond)),
+			BytesIn:     Counter(row.Get("bytes_in").GetInt64()),
+			BytesOut:    Counter(row.Get("bytes_out").GetInt64()),
+		})
+	}
+	return out, nil
+}
+
 // ClientRequestStats returns info about client requests which happen at the
 // coordinator level
 func (c *jolokiaClient) ClientRequestStats() ([]ClientRequestStats, error) {
@@ -321,11 +978,12 @@ func (c *jolokiaClient) ClientRequestStats() ([]ClientRequestStats, error) {
 	stats := map[string]*ClientRequestStats{}
 	v.Get("value").GetObject().Visit(func(key []byte, val *fastjson.Value) {
 		attributes := extractAttributes(string(key))
-		requestType := attributes["scope"] // requestType is embedded as scope
-		stat, ok := stats[requestType]
+		scope := attributes["scope"] // requestType is embedded as scope
+		stat, ok := stats[scope]
 		if !ok {
-			stat = &ClientRequestStats{RequestType: requestType}
-			stats[requestType] = stat
+			requestType, consistencyLevel := parseRequestScope(scope)
+			stat = &ClientRequestStats{RequestType: requestType, ConsistencyLevel: consistencyLevel}
+			stats[scope] = stat
 		}
 
 		switch attributes["name"] {
@@ -347,19 +1005,75 @@ func (c *jolokiaClient) ClientRequestStats() ([]ClientRequestStats, error) {
 	// assuming the response from Jolokia is consistent. Thus, we sort our
 	// pools in the output by Pool Name
 	names := make([]string, 0, len(stats))
-	for requestType := range stats {
-		names = append(names, requestType)
+	for scope := range stats {
+		names = append(names, scope)
 	}
 	sort.Strings(names)
 
 	out := make([]ClientRequestStats, 0, len(names))
-	for _, requestType := range names {
-		out = append(out, *stats[requestType])
+	for _, scope := range names {
+		out = append(out, *stats[scope])
 	}
 	return out, nil
 
 }
 
+// CASRequestStats returns info about lightweight transactions (CAS) which
+// happen at the coordinator level such as Paxos contention
+func (c *jolokiaClient) CASRequestStats() ([]CASRequestStats, error) {
+	requestTypes := []string{"CASRead", "CASWrite"}
+
+	mbeanGroups := make([][]string, 0, len(requestTypes))
+	for _, requestType := range requestTypes {
+		mbeanGroups = append(mbeanGroups, []string{
+			"type=ClientRequest",
+			fmt.Sprintf("scope=%s", requestType),
+			"name=*",
+		})
+	}
+
+	v, err := c.bulkRequest("org.apache.cassandra.metrics", mbeanGroups, [][]string{})
+	if err != nil {
+		return []CASRequestStats{}, fmt.Errorf("err reading CAS request stats: %v", err)
+	}
+
+	out := make([]CASRequestStats, 0, len(requestTypes))
+	for idx, item := range v.GetArray() {
+		if item.Get("status").GetInt64() != http.StatusOK || idx >= len(requestTypes) {
+			continue
+		}
+
+		stat := CASRequestStats{RequestType: requestTypes[idx]}
+		item.Get("value").GetObject().Visit(func(key []byte, val *fastjson.Value) {
+			attributes := extractAttributes(string(key))
+			switch attributes["name"] {
+			case "ContentionHistogram":
+				stat.Contention = parseHistogram(val)
+			case "UnfinishedCommit":
+				stat.UnfinishedCommit = Counter(val.Get("Count").GetInt64())
+			case "ConditionNotMet":
+				stat.ConditionNotMet = Counter(val.Get("Count").GetInt64())
+			case "UnknownResult":
+				stat.UnknownResult = Counter(val.Get("Count").GetInt64())
+			default:
+				// Paxos v2 (Cassandra 4.1+) adds a handful of repair meters.
+				// Older versions don't have them so nothing is added
+				if name := attributes["name"]; strings.Contains(name, "Repair") {
+					stat.PaxosRepairs = append(stat.PaxosRepairs, PaxosRepairMeter{
+						Name:  name,
+						Count: Counter(val.Get("Count").GetInt64()),
+					})
+				}
+			}
+		})
+		sort.Slice(stat.PaxosRepairs, func(i, j int) bool {
+			return stat.PaxosRepairs[i].Name < stat.PaxosRepairs[j].Name
+		})
+		out = append(out, stat)
+	}
+	return out, nil
+}
+
 // ConnectedClients returns the number of connected clients via the Native
 // Protocol in Cassandra
 func (c *jolokiaClient) ConnectedClients() (Gauge, error) {
@@ -373,6 +1087,194 @@ func (c *jolokiaClient) ConnectedClients() (Gauge, error) {
 	return Gauge(v.Get("value", "Value").GetInt64()), nil
 }
 
+// ClientConnectionStats returns the number of connected clients broken down by
+// user, driver and protocol version. This can be expensive if there are lots
+// of connected clients
+func (c *jolokiaClient) ClientConnectionStats() (ClientConnectionStats, error) {
+	mbeanGroups := [][]string{
+		{"type=Client", "name=connectedNativeClientsByUser"},
+		{"type=Client", "name=connections"},
+	}
+
+	v, err := c.bulkRequest("org.apache.cassandra.metrics", mbeanGroups, [][]string{})
+	if err != nil {
+		return ClientConnectionStats{}, fmt.Errorf("err reading client connection stats: %v", err)
+	}
+
+	byUser := map[ClientConnectionGroup]Gauge{}
+	byDriver := map[ClientConnectionGroup]Gauge{}
+	byProtocolVersion := map[ClientConnectionGroup]Gauge{}
+	for _, item := range v.GetArray() {
+		if item.Get("status").GetInt64() != http.StatusOK {
+			continue
+		}
+
+		attributes := extractAttributes(string(item.Get("request", "mbean").GetStringBytes()))
+		val := item.Get("value", "Value")
+		switch attributes["name"] {
+		case "connectedNativeClientsByUser":
+			val.GetObject().Visit(func(key []byte, count *fastjson.Value) {
+				byUser[ClientConnectionGroup{Name: string(key)}] += Gauge(count.GetInt64())
+			})
+		case "connections":
+			// Each connection is a map of string properties about the client
+			// such as the driver it's using (which may be missing)
+			for _, conn := range val.GetArray() {
+				driver := ClientConnectionGroup{
+					Name:    stringOrDefault(conn.Get("driverName"), "unknown"),
+					Version: stringOrDefault(conn.Get("driverVersion"), "unknown"),
+				}
+				byDriver[driver]++
+
+				protocolVersion := ClientConnectionGroup{Name: stringOrDefault(conn.Get("version"), "unknown")}
+				byProtocolVersion[protocolVersion]++
+			}
+		}
+	}
+
+	return ClientConnectionStats{
+		ByUser:            sortClientConnectionGroups(byUser),
+		ByDriver:          sortClientConnectionGroups(byDriver),
+		ByProtocolVersion: sortClientConnectionGroups(byProtocolVersion),
+	}, nil
+}
+
+// sortClientConnectionGroups converts our map of groups into a list which is
+// sorted by the number of connections (largest first) and then by name
+func sortClientConnectionGroups(groups map[ClientConnectionGroup]Gauge) []ClientConnectionGroup {
+	out := make([]ClientConnectionGroup, 0, len(groups))
+	for group, connections := range groups {
+		group.Connections = connections
+		out = append(out, group)
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Connections != out[j].Connections {
+			return out[i].Connections > out[j].Connections
+		}
+		if out[i].Name != out[j].Name {
+			return out[i].Name < out[j].Name
+		}
+		return out[i].Version < out[j].Version
+	})
+	return out
+}
+
+// NativeTransportStats returns info about the health of the Native Protocol
+// server such as backpressure being applied to clients
+func (c *jolokiaClient) NativeTransportStats() (NativeTransportStats, error) {
+	// Like ConnectedClients, we ask for specific metrics because the client
+	// metrics include lists of every connected client
+	metricItems := []string{
+		"PausedConnections",
+		"RequestDiscarded",
+		"TotalQueuedRequests",
+		"RequestsSize",
+		"RequestSize",
+	}
+
+	mbeanGroups := make([][]string, 0, len(metricItems))
+	for _, name := range metricItems {
+		mbeanGroups = append(mbeanGroups, []string{
+			"type=Client",
+			fmt.Sprintf("name=%s", name),
+		})
+	}
+
+	v, err := c.bulkRequest("org.apache.cassandra.metrics", mbeanGroups, [][]string{})
+	if err != nil {
+		return NativeTransportStats{}, fmt.Errorf("err reading native transport stats: %v", err)
+	}
+
+	stats := NativeTransportStats{}
+	for _, item := range v.GetArray() {
+		if item.Get("status").GetInt64() != http.StatusOK {
+			continue
+		}
+
+		attributes := extractAttributes(string(item.Get("request", "mbean").GetStringBytes()))
+		val := item.Get("value")
+		switch attributes["name"] {
+		case "PausedConnections":
+			stats.PausedConnections = Gauge(val.Get("Value").GetInt64())
+		case "RequestDiscarded":
+			stats.RequestsDiscarded = Counter(val.Get("Count").GetInt64())
+		case "TotalQueuedRequests":
+			stats.QueuedRequests = Gauge(val.Get("Count").GetInt64())
+		case "RequestsSize":
+			stats.RequestsSizeInFlight = BytesGauge(val.Get("Value").GetInt64())
+		case "RequestSize":
+			stats.RequestSize = parseHistogram(val)
+		}
+	}
+	return stats, nil
+}
+
+// AuthCacheStats returns info about each of the auth caches (such as the
+// roles and permissions caches) in Cassandra 4.0+
+func (c *jolokiaClient) AuthCacheStats() ([]AuthCacheStats, error) {
+	// Each auth cache registers an MBean under org.apache.cassandra.auth so
+	// we use that to discover which caches exist on this version
+	v, err := c.search("org.apache.cassandra.auth", "type=*")
+	if err != nil {
+		return []AuthCacheStats{}, fmt.Errorf("err searching auth caches: %v", err)
+	}
+
+	names := []string{}
+	for _, mbean := range v.GetArray("value") {
+		attributes := extractAttributes(string(mbean.GetStringBytes()))
+		if cacheName := attributes["type"]; strings.HasSuffix(cacheName, "Cache") {
+			names = append(names, cacheName)
+		}
+	}
+	sort.Strings(names)
+
+	if len(names) == 0 {
+		return []AuthCacheStats{}, nil
+	}
+
+	// Auth caches report their metrics as unweighted caches (Cassandra 4.1+)
+	// rather than under type=Cache which is used by the key, row and counter
+	// caches
+	mbeanGroups := make([][]string, 0, len(names))
+	for _, name := range names {
+		mbeanGroups = append(mbeanGroups, []string{
+			"type=UnweightedCache",
+			fmt.Sprintf("scope=%s", name),
+			"name=*",
+		})
+	}
+
+	v, err = c.bulkRequest("org.apache.cassandra.metrics", mbeanGroups, [][]string{})
+	if err != nil {
+		return []AuthCacheStats{}, fmt.Errorf("err reading auth cache stats: %v", err)
+	}
+
+	out := make([]AuthCacheStats, 0, len(names))
+	for idx, item := range v.GetArray() {
+		if item.Get("status").GetInt64() != http.StatusOK || idx >= len(names) {
+			continue
+		}
+
+		stat := AuthCacheStats{CacheName: names[idx]}
+		item.Get("value").GetObject().Visit(func(key []byte, val *fastjson.Value) {
+			attributes := extractAttributes(string(key))
+			switch attributes["name"] {
+			case "Hits":
+				stat.Hits = Counter(val.Get("Count").GetInt64())
+			case "Misses":
+				stat.Misses = Counter(val.Get("Count").GetInt64())
+			case "Entries":
+				stat.Size = Gauge(val.Get("Value").GetInt64())
+			case "MaxEntries":
+				stat.Capacity = Gauge(val.Get("Value").GetInt64())
+			}
+		})
+		out = append(out, stat)
+	}
+	return out, nil
+}
+
 // MemoryStats returns memory information about the Java process
 func (c *jolokiaClient) MemoryStats() (MemoryStats, error) {
 	v, err := c.read("java.lang", "type=Memory/*")
@@ -381,8 +1283,147 @@ func (c *jolokiaClient) MemoryStats() (MemoryStats, error) {
 	}
 
 	return MemoryStats{
-		HeapUsed:    BytesGauge(v.Get("value", "HeapMemoryUsage", "used").GetInt64()),
-		NonHeapUsed: BytesGauge(v.Get("value", "NonHeapMemoryUsage", "used").GetInt64()),
+		HeapUsed:         BytesGauge(v.Get("value", "HeapMemoryUsage", "used").GetInt64()),
+		HeapCommitted:    BytesGauge(v.Get("value", "HeapMemoryUsage", "committed").GetInt64()),
+		HeapMax:          BytesGauge(v.Get("value", "HeapMemoryUsage", "max").GetInt64()),
+		NonHeapUsed:      BytesGauge(v.Get("value", "NonHeapMemoryUsage", "used").GetInt64()),
+		NonHeapCommitted: BytesGauge(v.Get("value", "NonHeapMemoryUsage", "committed").GetInt64()),
+	}, nil
+}
+
+// JVMStats returns information about the Java process such as its uptime,
+// threads, loaded classes and time spent compiling. This is all fetched in a
+// single bulk request
+func (c *jolokiaClient) JVMStats() (JVMStats, error) {
+	mbeanGroups := [][]string{
+		{"type=Runtime"},
+		{"type=Threading"},
+		{"type=ClassLoading"},
+		{"type=Compilation"},
+	}
+	attributes := [][]string{
+		{"VmVendor", "VmVersion", "InputArguments", "StartTime", "Uptime"},
+		{"ThreadCount", "DaemonThreadCount", "PeakThreadCount", "TotalStartedThreadCount"},
+		{"LoadedClassCount", "TotalLoadedClassCount", "UnloadedClassCount"},
+		{"TotalCompilationTime"},
+	}
+
+	v, err := c.bulkRequest("java.lang", mbeanGroups, attributes)
+	if err != nil {
+		return JVMStats{}, fmt.Errorf("err reading JVM stats: %v", err)
+	}
+
+	stats := JVMStats{}
+	for _, item := range v.GetArray() {
+		if item.Get("status").GetInt64() != http.StatusOK {
+			continue
+		}
+
+		val := item.Get("value")
+		switch extractAttributes(string(item.Get("request", "mbean").GetStringBytes()))["type"] {
+		case "Runtime":
+			stats.Vendor = string(val.Get("VmVendor").GetStringBytes())
+			stats.Version = string(val.Get("VmVersion").GetStringBytes())
+			stats.InputArgumentsHash = hashStrings(valueToStringArray(val.GetArray("InputArguments")))
+			stats.StartTime = time.Unix(0, val.Get("StartTime").GetInt64()*int64(time.Millisecond))
+			stats.Uptime = time.Duration(val.Get("Uptime").GetInt64()) * time.Millisecond
+		case "Threading":
+			stats.HasThreads = true
+			stats.Threads = Gauge(val.Get("ThreadCount").GetInt64())
+			stats.DaemonThreads = Gauge(val.Get("DaemonThreadCount").GetInt64())
+			stats.PeakThreads = Gauge(val.Get("PeakThreadCount").GetInt64())
+			stats.ThreadsStarted = Counter(val.Get("TotalStartedThreadCount").GetInt64())
+		case "ClassLoading":
+			stats.HasClasses = true
+			stats.LoadedClasses = Gauge(val.Get("LoadedClassCount").GetInt64())
+			stats.ClassesLoaded = Counter(val.Get("TotalLoadedClassCount").GetInt64())
+			stats.ClassesUnloaded = Counter(val.Get("UnloadedClassCount").GetInt64())
+		case "Compilation":
+			stats.HasCompilationTime = true
+			stats.CompilationTime = time.Duration(val.Get("TotalCompilationTime").GetInt64()) * time.Millisecond
+		}
+	}
+
+	// The runtime info is what everything else hangs off so if we couldn't
+	// get it, we treat the whole request as failed
+	if stats.StartTime.IsZero() || stats.Uptime == 0 {
+		return JVMStats{}, fmt.Errorf("err reading JVM runtime stats")
+	}
+	return stats, nil
+}
+
+// MemoryPoolStats returns information about each of the memory pools in the
+// process such as the Eden space, Old Gen and Metaspace
+func (c *jolokiaClient) MemoryPoolStats() ([]MemoryPoolStats, error) {
+	// We only ask for the attributes we need because some of the other ones
+	// (like the usage thresholds) error for pools which don't support them
+	v, err := c.read("java.lang", "type=MemoryPool,name=*/Name,Type,Usage,CollectionUsage")
+	if err != nil {
+		return []MemoryPoolStats{}, fmt.Errorf("err reading memory pool stats: %v", err)
+	}
+
+	stats := []MemoryPoolStats{}
+	v.Get("value").GetObject().Visit(func(_ []byte, val *fastjson.Value) {
+		stat := MemoryPoolStats{
+			Name:       string(val.Get("Name").GetStringBytes()),
+			Type:       string(val.Get("Type").GetStringBytes()),
+			Used:       BytesGauge(val.Get("Usage", "used").GetInt64()),
+			Committed:  BytesGauge(val.Get("Usage", "committed").GetInt64()),
+			Max:        BytesGauge(val.Get("Usage", "max").GetInt64()),
+			PostGCUsed: -1,
+		}
+		if usage := val.Get("CollectionUsage"); usage != nil && usage.Type() == fastjson.TypeObject {
+			stat.PostGCUsed = BytesGauge(usage.Get("used").GetInt64())
+		}
+		stats = append(stats, stat)
+	})
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Name < stats[j].Name })
+	return stats, nil
+}
+
+// BufferPoolStats returns information about the direct and mapped buffer
+// pools in the process. Direct buffers are allocated outside of the heap so
+// won't show up in any of the heap stats
+func (c *jolokiaClient) BufferPoolStats() ([]BufferPoolStats, error) {
+	v, err := c.read("java.nio", "type=BufferPool,name=*")
+	if err != nil {
+		return []BufferPoolStats{}, fmt.Errorf("err reading buffer pool stats: %v", err)
+	}
+
+	stats := []BufferPoolStats{}
+	v.Get("value").GetObject().Visit(func(_ []byte, val *fastjson.Value) {
+		stats = append(stats, BufferPoolStats{
+			Name:          string(val.Get("Name").GetStringBytes()),
+			Buffers:       Gauge(val.Get("Count").GetInt64()),
+			Used:          BytesGauge(val.Get("MemoryUsed").GetInt64()),
+			TotalCapacity: BytesGauge(val.Get("TotalCapacity").GetInt64()),
+		})
+	})
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Name < stats[j].Name })
+	return stats, nil
+}
+
+// OperatingSystemStats returns information about the resources used by the
+// process (such as file descriptors and CPU) and the machine it's running on
+func (c *jolokiaClient) OperatingSystemStats() (OperatingSystemStats, error) {
+	v, err := c.read("java.lang", "type=OperatingSystem")
+	if err != nil {
+		return OperatingSystemStats{}, fmt.Errorf("err reading operating system stats: %v", err)
+	}
+
+	val := v.Get("value")
+	return OperatingSystemStats{
+		OpenFileDescriptors: Gauge(val.Get("OpenFileDescriptorCount").GetInt64()),
+		MaxFileDescriptors:  Gauge(val.Get("MaxFileDescriptorCount").GetInt64()),
+		ProcessCPUTime:      time.Duration(val.Get("ProcessCpuTime").GetInt64()),
+		ProcessCPULoad:      FloatGauge(val.Get("ProcessCpuLoad").GetFloat64()),
+		SystemCPULoad:       FloatGauge(val.Get("SystemCpuLoad").GetFloat64()),
+		SystemLoadAverage:   FloatGauge(val.Get("SystemLoadAverage").GetFloat64()),
+		AvailableProcessors: Gauge(val.Get("AvailableProcessors").GetInt64()),
+		FreePhysicalMemory:  BytesGauge(val.Get("FreePhysicalMemorySize").GetInt64()),
+		TotalPhysicalMemory: BytesGauge(val.Get("TotalPhysicalMemorySize").GetInt64()),
+		FreeSwapSpace:       BytesGauge(val.Get("FreeSwapSpaceSize").GetInt64()),
+		TotalSwapSpace:      BytesGauge(val.Get("TotalSwapSpaceSize").GetInt64()),
 	}, nil
 }
 
@@ -445,6 +1486,76 @@ func (c *jolokiaClient) StorageStats() (StorageStats, error) {
 	return stats, nil
 }
 
+// SnapshotStats gives information about the snapshots on disk such as their
+// total size and how many snapshots each table has and their size. Each part
+// is best effort and we only return an error if none of them could be read
+func (c *jolokiaClient) SnapshotStats() (SnapshotStats, error) {
+	stats := SnapshotStats{}
+
+	// The true snapshot size is an operation rather than an attribute so we
+	// need to execute it rather than read it
+	size, sizeErr := c.exec("org.apache.cassandra.db", "trueSnapshotsSize", "type=StorageService")
+	if sizeErr == nil {
+		trueSize := BytesGauge(size.Get("value").GetInt64())
+		stats.TrueSize = &trueSize
+	}
+
+	// Snapshot details has a row per snapshot per table. A table may have
+	// multiple rows for the same snapshot so we count the unique names
+	details, detailsErr := c.read("org.apache.cassandra.db", "type=StorageService/SnapshotDetails")
+	if detailsErr == nil {
+		snapshots := map[Table]map[string]struct{}{}
+		for _, row := range tabularRows(details.Get("value"), "Snapshot name") {
+			table := Table{
+				KeyspaceName: string(row.Get("Keyspace name").GetStringBytes()),
+				TableName:    string(row.Get("Column family name").GetStringBytes()),
+			}
+			if _, ok := snapshots[table]; !ok {
+				snapshots[table] = map[string]struct{}{}
+			}
+			snapshots[table][string(row.Get("Snapshot name").GetStringBytes())] = struct{}{}
+		}
+
+		stats.Tables = make([]TableSnapshots, 0, len(snapshots))
+		for table, names := range snapshots {
+			stats.Tables = append(stats.Tables, TableSnapshots{Table: table, Snapshots: Gauge(len(names))})
+		}
+		sort.Slice(stats.Tables, func(i, j int) bool {
+			a := fmt.Sprintf("%s.%s", stats.Tables[i].Table.KeyspaceName, stats.Tables[i].Table.TableName)
+			b := fmt.Sprintf("%s.%s", stats.Tables[j].Table.KeyspaceName, stats.Tables[j].Table.TableName)
+			return a < b
+		})
+	}
+
+	// Every table has its own SnapshotsSize gauge so we read them all at once
+	// rather than as part of each table's stats
+	sizes, sizesErr := c.read("org.apache.cassandra.metrics", "type=Table", "name=SnapshotsSize", "*")
+	if sizesErr == nil {
+		stats.TableSizes = []TableSnapshotsSize{}
+		sizes.Get("value").GetObject().Visit(func(key []byte, val *fastjson.Value) {
+			attributes := extractAttributes(string(key))
+			if attributes["type"] != "Table" {
+				return
+			}
+			stats.TableSizes = append(stats.TableSizes, TableSnapshotsSize{
+				Table: Table{KeyspaceName: attributes["keyspace"], TableName: attributes["scope"]},
+				Size:  BytesGauge(val.Get("Value").GetInt64()),
+			})
+		})
+		sort.Slice(stats.TableSizes, func(i, j int) bool {
+			a := fmt.Sprintf("%s.%s", stats.TableSizes[i].Table.KeyspaceName, stats.TableSizes[i].Table.TableName)
+			b := fmt.Sprintf("%s.%s", stats.TableSizes[j].Table.KeyspaceName, stats.TableSizes[j].Table.TableName)
+			return a < b
+		})
+	}
+
+	if sizeErr != nil && detailsErr != nil && sizesErr != nil {
+		return SnapshotStats{}, fmt.Errorf("err reading true snapshots size: %v, err reading snapshot details: %v, "+
+			"err reading table snapshot sizes: %v", sizeErr, detailsErr, sizesErr)
+	}
+	return stats, nil
+}
+
 // StorageCoreStats gives information on hints and internal exceptions
 func (c *jolokiaClient) StorageCoreStats() (StorageCoreStats, error) {
 	v, err := c.read("org.apache.cassandra.metrics", "type=Storage", "name=*")
@@ -467,6 +1578,182 @@ func (c *jolokiaClient) StorageCoreStats() (StorageCoreStats, error) {
 	return stats, nil
 }
 
+// MessagingStats gives information about internode messaging latency across
+// the cluster and to each of the datacenters
+func (c *jolokiaClient) MessagingStats() (MessagingStats, error) {
+	v, err := c.read("org.apache.cassandra.metrics", "type=Messaging", "name=*")
+	if err != nil {
+		return MessagingStats{}, fmt.Errorf("err reading messaging stats: %v", err)
+	}
+
+	// Cassandra names the per datacenter timers as <dc>-Latency and we don't
+	// know the datacenter names upfront so we discover them from the response
+	stats := MessagingStats{}
+	datacenters := map[string]Latency{}
+	v.Get("value").GetObject().Visit(func(key []byte, val *fastjson.Value) {
+		attributes := extractAttributes(string(key))
+		name := attributes["name"]
+		switch {
+		case name == "CrossNodeLatency":
+			stats.CrossNodeLatency = parseLatency(val)
+		case strings.HasSuffix(name, "-Latency"):
+			datacenters[strings.TrimSuffix(name, "-Latency")] = parseLatency(val)
+		}
+	})
+
+	names := make([]string, 0, len(datacenters))
+	for dc := range datacenters {
+		names = append(names, dc)
+	}
+	sort.Strings(names)
+
+	stats.DatacenterLatency = make([]DatacenterLatency, 0, len(names))
+	for _, dc := range names {
+		stats.DatacenterLatency = append(stats.DatacenterLatency, DatacenterLatency{
+			Datacenter: dc,
+			Latency:    datacenters[dc],
+		})
+	}
+	return stats, nil
+}
+
+// HintsStats gives information about hint delivery such as how many hints
+// succeeded or failed and how delayed hints are to each endpoint
+func (c *jolokiaClient) HintsStats() (HintsStats, error) {
+	v, err := c.read("org.apache.cassandra.metrics", "type=HintsService", "name=*")
+	if err != nil {
+		return HintsStats{}, fmt.Errorf("err reading hints stats: %v", err)
+	}
+
+	// Each endpoint gets its own histogram named Hint_delays-<endpoint>. The
+	// endpoint is mangled by Cassandra so we turn it back into an address
+	stats := HintsStats{}
+	delays := map[string]Histogram{}
+	v.Get("value").GetObject().Visit(func(key []byte, val *fastjson.Value) {
+		attributes := extractAttributes(string(key))
+		name := attributes["name"]
+		switch {
+		case name == "HintsSucceeded":
+			stats.Succeeded = Counter(val.Get("Count").GetInt64())
+		case name == "HintsFailed":
+			stats.Failed = Counter(val.Get("Count").GetInt64())
+		case name == "HintsTimedOut":
+			stats.TimedOut = Counter(val.Get("Count").GetInt64())
+		case strings.HasPrefix(name, "Hint_delays-"):
+			endpoint := parseHintEndpoint(strings.TrimPrefix(name, "Hint_delays-"))
+			delays[endpoint] = parseHistogram(val)
+		}
+	})
+
+	endpoints := make([]string, 0, len(delays))
+	for endpoint := range delays {
+		endpoints = append(endpoints, endpoint)
+	}
+	sort.Strings(endpoints)
+
+	stats.Delays = make([]EndpointHintDelay, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		stats.Delays = append(stats.Delays, EndpointHintDelay{
+			Endpoint: endpoint,
+			Delay:    delays[endpoint],
+		})
+	}
+	return stats, nil
+}
+
+// ReadRepairStats gives information about read repairs which have been
+// attempted and performed by this node as a coordinator
+func (c *jolokiaClient) ReadRepairStats() (ReadRepairStats, error) {
+	v, err := c.read("org.apache.cassandra.metrics", "type=ReadRepair", "name=*")
+	if err != nil {
+		return ReadRepairStats{}, fmt.Errorf("err reading read repair stats: %v", err)
+	}
+
+	stats := ReadRepairStats{}
+	v.Get("value").GetObject().Visit(func(key []byte, val *fastjson.Value) {
+		attributes := extractAttributes(string(key))
+		switch attributes["name"] {
+		case "RepairedBlocking":
+			stats.RepairedBlocking = Counter(val.Get("Count").GetInt64())
+		case "RepairedBackground":
+			stats.RepairedBackground = Counter(val.Get("Count").GetInt64())
+		case "Attempted":
+			stats.Attempted = Counter(val.Get("Count").GetInt64())
+		case "SpeculatedRead":
+			stats.SpeculatedRead = Counter(val.Get("Count").GetInt64())
+		case "SpeculatedWrite":
+			stats.SpeculatedWrite = Counter(val.Get("Count").GetInt64())
+		case "ReconcileRead":
+			stats.ReconcileRead = Counter(val.Get("Count").GetInt64())
+		}
+	})
+	return stats, nil
+}
+
+// RepairStats gives information about the repair sessions which this node is
+// taking part in
+func (c *jolokiaClient) RepairStats() (RepairStats, error) {
+	// getSessions needs a null ranges argument to return sessions for all
+	// ranges which we can't pass through exec so we do a bulk exec instead
+	v, err := c.bulkExec("org.apache.cassandra.db", []string{"type=RepairService"}, "getSessions",
+		[][]interface{}{{false, nil}})
+	if err != nil {
+		return RepairStats{}, fmt.Errorf("err reading repair sessions: %v", err)
+	}
+
+	item := v.Get("0")
+	if item.Get("status").GetInt64() != http.StatusOK {
+		return RepairStats{}, fmt.Errorf("expected 200 response from Jolokia, got %v", item.Get("status").GetInt64())
+	}
+
+	sessions := map[string]Gauge{}
+	for _, session := range item.GetArray("value") {
+		state := stringOrDefault(session.Get("STATE"), "UNKNOWN")
+		sessions[state]++
+	}
+
+	stats := RepairStats{Sessions: make([]RepairSessionState, 0, len(sessions))}
+	for state, count := range sessions {
+		stats.Sessions = append(stats.Sessions, RepairSessionState{State: state, Sessions: count})
+	}
+	sort.Slice(stats.Sessions, func(i, j int) bool {
+		return stats.Sessions[i].State < stats.Sessions[j].State
+	})
+
+	// Preview repair failures are only available in Cassandra 4.0+ so we
+	// don't fail if we can't read them
+	failures, err := c.read("org.apache.cassandra.metrics", "type=Repair", "name=PreviewFailures")
+	if err == nil {
+		stats.PreviewFailures = Counter(failures.Get("value", "Count").GetInt64())
+	}
+	return stats, nil
+}
+
+// ViewWriteStats gives information about how updates to materialized views
+// are being replicated when this node is the coordinator
+func (c *jolokiaClient) ViewWriteStats() (ViewWriteStats, error) {
+	v, err := c.read("org.apache.cassandra.metrics", "type=ClientRequest", "scope=ViewWrite", "name=*")
+	if err != nil {
+		return ViewWriteStats{}, fmt.Errorf("err reading view write stats: %v", err)
+	}
+
+	stats := ViewWriteStats{}
+	v.Get("value").GetObject().Visit(func(key []byte, val *fastjson.Value) {
+		attributes := extractAttributes(string(key))
+		switch attributes["name"] {
+		case "ViewReplicasAttempted":
+			stats.ReplicasAttempted = Counter(val.Get("Count").GetInt64())
+		case "ViewReplicasSuccess":
+			stats.ReplicasSuccess = Counter(val.Get("Count").GetInt64())
+		case "ViewPendingMutations":
+			stats.PendingMutations = Gauge(val.Get("Value").GetInt64())
+		case "ViewWriteLatency":
+			stats.WriteLatency = parseLatency(val)
+		}
+	})
+	return stats, nil
+}
+
 // get makes a GET request to the targetPath and returns the contents of the
 // body as a JSON value ready for items to be plucked. If any part of the
 // request pipeline fails, an err is returned
@@ -521,13 +1808,42 @@ func (c *jolokiaClient) bulkRequest(metricName string, mbeanGroups [][]string, a
 	if err != nil {
 		return nil, fmt.Errorf("could not build bulkRequest body: %v", err)
 	}
+	return c.post("/jolokia/read", bodyBytes)
+}
+
+// bulkExec is like bulkRequest but executes the same operation on an mbean
+// once for each list of arguments. Responses are provided in the order of
+// the arguments
+func (c *jolokiaClient) bulkExec(metricName string, mbean []string, operation string, arguments [][]interface{}) (*fastjson.Value, error) {
+	queries := make([]map[string]interface{}, 0, len(arguments))
+	for _, args := range arguments {
+		queries = append(queries, map[string]interface{}{
+			"type":      "exec",
+			"mbean":     fmt.Sprintf("%s:%s", metricName, strings.Join(mbean, ",")),
+			"operation": operation,
+			"arguments": args,
+		})
+	}
+
+	bodyBytes, err := json.Marshal(queries)
+	if err != nil {
+		return nil, fmt.Errorf("could not build bulkExec body: %v", err)
+	}
+	return c.post("/jolokia/exec", bodyBytes)
+}
+
+// post makes a POST request with the given body to the targetPath and
+// returns the contents of the response body as a JSON value. Unlike get, the
+// Jolokia response code isn't checked because bulk responses have a response
+// code per item
+func (c *jolokiaClient) post(targetPath string, bodyBytes []byte) (*fastjson.Value, error) {
 	reader := bytes.NewReader(bodyBytes)
 
 	u, err := url.Parse(fmt.Sprintf("%v", c.endpoint))
 	if err != nil {
 		return nil, err
 	}
-	u.Path = path.Join(u.Path, "/jolokia/read")
+	u.Path = path.Join(u.Path, targetPath)
 
 	rsp, err := c.httpClient.Post(u.String(), "application/json", reader)
 	if err != nil {
@@ -560,6 +1876,20 @@ func (c *jolokiaClient) read(metricName string, kv ...string) (*fastjson.Value,
 	return c.get(targetPath)
 }
 
+// search is like read but returns the names of the mbeans matching the
+// pattern rather than their attributes. Unlike read, no matches isn't an error
+func (c *jolokiaClient) search(metricName string, kv ...string) (*fastjson.Value, error) {
+	targetPath := fmt.Sprintf("/jolokia/search/%v:%v", metricName, strings.Join(kv, ","))
+	return c.get(targetPath)
+}
+
+// exec is like read but executes an operation (which takes no arguments) on
+// an mbean rather than reading its attributes
+func (c *jolokiaClient) exec(metricName, operation string, kv ...string) (*fastjson.Value, error) {
+	targetPath := fmt.Sprintf("/jolokia/exec/%v:%v/%v", metricName, strings.Join(kv, ","), operation)
+	return c.get(targetPath)
+}
+
 func buildBulkRequestBody(metricName string, mbeanGroups [][]string, attributes [][]string) ([]byte, error) {
 	if len(attributes) > 0 && len(mbeanGroups) != len(attributes) {
 		return nil, fmt.Errorf("expected groups and attributes to be the same length")
@@ -0,0 +1,40 @@
+package jolokia
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valyala/fastjson"
+)
+
+func TestParseKeyspaceStats(t *testing.T) {
+	val, err := fastjson.Parse(`[
+		{
+			"status": 200,
+			"value": {
+				"org.apache.cassandra.metrics:keyspace=b,name=PendingCompactions,type=Keyspace": {"Value": 3},
+				"org.apache.cassandra.metrics:keyspace=a,name=PendingCompactions,type=Keyspace": {"Value": 1}
+			}
+		},
+		{
+			"status": 200,
+			"value": {
+				"org.apache.cassandra.metrics:keyspace=a,name=PendingFlushes,type=Keyspace": {"Count": 2},
+				"org.apache.cassandra.metrics:keyspace=b,name=PendingFlushes,type=Keyspace": {"Count": 5}
+			}
+		},
+		{
+			"status": 404,
+			"value": {
+				"org.apache.cassandra.metrics:keyspace=a,name=LiveDiskSpaceUsed,type=Keyspace": {"Value": 100}
+			}
+		}
+	]`)
+	require.NoError(t, err)
+
+	assert.Equal(t, []KeyspaceStats{
+		{KeyspaceName: "a", PendingCompactions: 1, PendingFlushes: 2},
+		{KeyspaceName: "b", PendingCompactions: 3, PendingFlushes: 5},
+	}, parseKeyspaceStats(val))
+}
@@ -36,6 +36,14 @@ type (
 		Mean          time.Duration
 		Count         Counter
 	}
+	// BucketedHistogram represents the raw buckets of one of Cassandra's
+	// estimated histograms. Buckets[i] counts the values greater than
+	// Offsets[i-1] and less than or equal to Offsets[i]. There is one more
+	// bucket than offsets which counts any values above the last offset
+	BucketedHistogram struct {
+		Offsets []int64
+		Buckets []Counter
+	}
 )
 
 // Client embeds all the methods which can be called by a Jolokia client
@@ -50,11 +58,40 @@ type Client interface {
 	// TableStats returns all the stats for a given Table from Cassandra
 	TableStats(table Table) (TableStats, error)
 
+	// TableConfig returns the configuration of a given Table from Cassandra
+	TableConfig(table Table) (TableConfig, error)
+
+	// SSTableLayout returns how the SSTables of a given Table are spread
+	// across levels or time windows
+	SSTableLayout(table Table) (SSTableLayout, error)
+
+	// Views returns whether each of the given tables is a materialized view
+	Views(tables []Table) (map[Table]bool, error)
+
+	// Indexes returns the list of secondary indexes (both legacy secondary
+	// indexes and storage-attached indexes) from Cassandra
+	Indexes() ([]Index, error)
+
+	// IndexStats returns all the stats for a given Index from Cassandra
+	IndexStats(index Index) (IndexStats, error)
+
+	// KeyspaceStats returns stats for each keyspace which are aggregated
+	// across all the tables in the keyspace by Cassandra
+	KeyspaceStats() ([]KeyspaceStats, error)
+
+	// MemtablePoolStats returns info about how often writes have had to wait
+	// for memtable space to be freed up
+	MemtablePoolStats() (MemtablePoolStats, error)
+
 	// CQLStats returns info about the kinds of CQL statements being processed
 	// and how many were prepared vs non-prepared. It also gives some insight
 	// into the Prepared Statement cache
 	CQLStats() (CQLStats, error)
 
+	// BatchStats returns info about how many partitions are touched by each
+	// kind of batch statement
+	BatchStats() (BatchStats, error)
+
 	// ThreadPoolStats returns info about each of the Thread Pools running
 	// in Cassandra
 	ThreadPoolStats() ([]ThreadPoolStats, error)
@@ -63,17 +100,58 @@ type Client interface {
 	// or are waiting in Cassandra
 	CompactionStats() (CompactionStats, error)
 
+	// ActiveCompactions returns info about the compactions (and other
+	// compaction manager tasks) which are currently running in Cassandra
+	ActiveCompactions() ([]ActiveCompaction, error)
+
+	// CompactionHistory returns the compactions which have completed
+	// recently in Cassandra (as kept in the compaction history)
+	CompactionHistory() ([]CompactionHistoryEntry, error)
+
 	// ClientRequestStats returns info about client requests which happen
 	// at the coordinator level
 	ClientRequestStats() ([]ClientRequestStats, error)
 
+	// CASRequestStats returns info about lightweight transactions (CAS)
+	// which happen at the coordinator level such as Paxos contention
+	CASRequestStats() ([]CASRequestStats, error)
+
 	// ConnectedClients returns the number of connected clients via the
 	// Native Protocol in Cassandra
 	ConnectedClients() (Gauge, error)
 
+	// ClientConnectionStats returns the number of connected clients broken
+	// down by user, driver and protocol version. This can be expensive if
+	// there are lots of connected clients
+	ClientConnectionStats() (ClientConnectionStats, error)
+
+	// NativeTransportStats returns info about the health of the Native
+	// Protocol server such as backpressure being applied to clients
+	NativeTransportStats() (NativeTransportStats, error)
+
+	// AuthCacheStats returns info about each of the auth caches (such as
+	// the roles and permissions caches) in Cassandra 4.0+
+	AuthCacheStats() ([]AuthCacheStats, error)
+
 	// MemoryStats returns memory information about the Java process
 	MemoryStats() (MemoryStats, error)
 
+	// JVMStats returns information about the Java process such as its
+	// uptime, threads, loaded classes and time spent compiling
+	JVMStats() (JVMStats, error)
+
+	// MemoryPoolStats returns information about each of the memory pools
+	// (such as the Eden space or Metaspace) in the Java process
+	MemoryPoolStats() ([]MemoryPoolStats, error)
+
+	// BufferPoolStats returns information about the direct and mapped
+	// buffer pools in the Java process
+	BufferPoolStats() ([]BufferPoolStats, error)
+
+	// OperatingSystemStats returns information about the resources used by
+	// the Java process and the machine it's running on
+	OperatingSystemStats() (OperatingSystemStats, error)
+
 	// GarbageCollectorStats returns information about Garbage Collections
 	// that occur in the process. Since there are different kinds of GC
 	// processes occurring, the stats are returned as a list with an item for
@@ -85,9 +163,33 @@ type Client interface {
 	// are part of the cluster
 	StorageStats() (StorageStats, error)
 
+	// SnapshotStats gives information about the snapshots on disk such as
+	// their total size and how many snapshots each table has
+	SnapshotStats() (SnapshotStats, error)
+
 	// StorageCoreStats gives information on the storage core such as
 	// hints and exceptions
 	StorageCoreStats() (StorageCoreStats, error)
+
+	// MessagingStats gives information about internode messaging latency
+	// across the cluster and to each of the datacenters
+	MessagingStats() (MessagingStats, error)
+
+	// HintsStats gives information about hint delivery such as how many
+	// hints succeeded or failed and how delayed hints are to each endpoint
+	HintsStats() (HintsStats, error)
+
+	// RepairStats gives information about the repair sessions which this
+	// node is taking part in
+	RepairStats() (RepairStats, error)
+
+	// ReadRepairStats gives information about read repairs which have been
+	// attempted and performed by this node as a coordinator
+	ReadRepairStats() (ReadRepairStats, error)
+
+	// ViewWriteStats gives information about how updates to materialized
+	// views are being replicated when this node is the coordinator
+	ViewWriteStats() (ViewWriteStats, error)
 }
 
 // Table embeds information about a Keyspace and Table that exists in
@@ -97,37 +199,180 @@ type Table struct {
 	TableName    string
 }
 
+// KeyspaceStats embeds the stats associated with a keyspace. These are
+// aggregated by Cassandra across all the tables in the keyspace
+type KeyspaceStats struct {
+	KeyspaceName string
+
+	ReadLatency  Latency
+	WriteLatency Latency
+	RangeLatency Latency
+
+	LiveDiskSpaceUsed  BytesGauge
+	TotalDiskSpaceUsed BytesGauge
+	PendingCompactions Gauge
+	PendingFlushes     Gauge
+}
+
+// The kinds of secondary index that can exist on a table
+const (
+	// IndexTypeSecondary is a legacy secondary index which is backed by a
+	// hidden table
+	IndexTypeSecondary = "secondary"
+	// IndexTypeSAI is a storage-attached index (Cassandra 5.0+)
+	IndexTypeSAI = "sai"
+)
+
+// Index embeds information about a secondary index on a table
+type Index struct {
+	Table     Table
+	IndexName string
+	Type      string
+}
+
+// IndexStats embeds all the stats associated with a secondary index. Legacy
+// secondary indexes and storage-attached indexes expose different metrics so
+// only some of the stats will be set depending on the type of the index.
+// Built is nil if the build status couldn't be read
+type IndexStats struct {
+	Index Index
+	Built *bool
+
+	// Common stats
+	LiveDiskSpaceUsed BytesGauge
+	MemtableSize      BytesGauge
+	QueryLatency      Latency
+
+	// Legacy secondary index stats
+	TotalDiskSpaceUsed BytesGauge
+	LiveSSTables       Gauge
+
+	// Storage-attached index stats
+	SSTableCells              Gauge
+	MemtableIndexWrites       Gauge
+	MemtableIndexWriteLatency Latency
+	MemtableIndexFlushes      Counter
+	Compactions               Counter
+	SegmentsPerCompaction     Histogram
+}
+
 // TableStats embeds all the stats associated with a table
 type TableStats struct {
 	Table Table
 
 	// Latency stats
-	CoordinatorRead   Latency
-	CoordinatorWrite  Latency
-	CoordinatorScan   Latency
-	ReadLatency       Latency
-	WriteLatency      Latency
-	RangeLatency      Latency
-	CASProposeLatency Latency
-	CASCommitLatency  Latency
+	CoordinatorRead     Latency
+	CoordinatorWrite    Latency
+	CoordinatorScan     Latency
+	ReadLatency         Latency
+	WriteLatency        Latency
+	RangeLatency        Latency
+	CASProposeLatency   Latency
+	CASCommitLatency    Latency
+	ViewLockAcquireTime Latency
+	ViewReadTime        Latency
 
 	// Table specific stats
-	EstimatedPartitionCount  Gauge
-	PendingCompactions       Gauge
-	LiveDiskSpaceUsed        Gauge
-	TotalDiskSpaceUsed       Gauge
-	LiveSSTables             Gauge
-	SSTablesPerRead          Histogram
-	MaxPartitionSize         BytesGauge
-	MeanPartitionSize        BytesGauge
-	BloomFilterFalseRatio    FloatGauge
-	TombstonesScanned        Histogram
-	LiveCellsScanned         Histogram
-	KeyCacheHitRate          FloatGauge
-	PercentRepaired          FloatGauge
-	SpeculativeRetries       Counter
-	SpeculativeFailedRetries Counter
-	CompressionRatio         FloatGauge
+	EstimatedPartitionCount     Gauge
+	PendingCompactions          Gauge
+	LiveDiskSpaceUsed           Gauge
+	TotalDiskSpaceUsed          Gauge
+	LiveSSTables                Gauge
+	SSTablesPerRead             Histogram
+	MaxPartitionSize            BytesGauge
+	MeanPartitionSize           BytesGauge
+	BloomFilterFalseRatio       FloatGauge
+	TombstonesScanned           Histogram
+	LiveCellsScanned            Histogram
+	KeyCacheHitRate             FloatGauge
+	PercentRepaired             FloatGauge
+	SpeculativeRetries          Counter
+	SpeculativeFailedRetries    Counter
+	CompressionRatio            FloatGauge
+	ReadRepairRequests          Counter
+	ShortReadProtectionRequests Counter
+	DroppedMutations            Counter
+	RowCacheHits                Counter
+	RowCacheMisses              Counter
+	RowCacheHitsOutOfRange      Counter
+	TombstoneWarnings           Counter
+	TombstoneFailures           Counter
+
+	// Repair stats (Cassandra 4.0+)
+	BytesRepaired                          BytesGauge
+	BytesUnrepaired                        BytesGauge
+	BytesPendingRepair                     BytesGauge
+	RepairedDataInconsistenciesConfirmed   Counter
+	RepairedDataInconsistenciesUnconfirmed Counter
+	AnticompactionTime                     Latency
+	ValidationTime                         Latency
+	RepairSyncTime                         Latency
+
+	// Memtable stats
+	MemtableLiveDataSize     BytesGauge
+	MemtableOnHeapSize       BytesGauge
+	MemtableOffHeapSize      BytesGauge
+	MemtableColumnsCount     Gauge
+	MemtableSwitchCount      Counter
+	PendingFlushes           Gauge
+	AllMemtablesLiveDataSize BytesGauge
+	AllMemtablesOnHeapSize   BytesGauge
+	AllMemtablesOffHeapSize  BytesGauge
+
+	// Off-heap memory stats
+	BloomFilterOffHeapMemoryUsed         BytesGauge
+	IndexSummaryOffHeapMemoryUsed        BytesGauge
+	CompressionMetadataOffHeapMemoryUsed BytesGauge
+	BloomFilterDiskSpaceUsed             BytesGauge
+
+	// Distributions across all SSTables
+	PartitionSizes BucketedHistogram
+	CellCounts     BucketedHistogram
+
+	// SSTable layout stats. These are only set for tables using
+	// LeveledCompactionStrategy or TimeWindowCompactionStrategy (see
+	// SSTableLayout)
+	SSTablesPerLevel  []Gauge
+	SSTablesPerWindow []WindowSSTables
+}
+
+// SSTableLayout embeds how the SSTables of a table are spread out. PerLevel
+// is only set for tables using LeveledCompactionStrategy and PerWindow is
+// only set for tables using TimeWindowCompactionStrategy (on Cassandra
+// versions which expose it)
+type SSTableLayout struct {
+	PerLevel  []Gauge
+	PerWindow []WindowSSTables
+}
+
+// TableConfig embeds the configuration of a table. CompactionStrategy and
+// Compression are the short class names (such as LeveledCompactionStrategy
+// and LZ4Compressor). Compression is "none" if compression is disabled for
+// the table and empty if it couldn't be read
+type TableConfig struct {
+	Table                   Table
+	CompactionStrategy      string
+	Compression             string
+	CompressionChunkLength  BytesGauge
+	MinCompactionThreshold  Gauge
+	MaxCompactionThreshold  Gauge
+	DroppableTombstoneRatio FloatGauge
+}
+
+// WindowSSTables embeds the number of SSTables in a single time window of a
+// table using TimeWindowCompactionStrategy. Window is the index of the window
+// with 0 being the newest. Only the newest windows are kept, any older ones
+// are summed into the oldest window we keep
+type WindowSSTables struct {
+	Window   int
+	SSTables Gauge
+}
+
+// MemtablePoolStats embeds stats about the memtable memory pool shared by
+// all tables
+type MemtablePoolStats struct {
+	BlockedOnAllocation        Counter
+	WaitingOnFreeMemtableSpace Latency
 }
 
 // CQLStats embeds stats about Prepared and Regular CQL statements
@@ -140,6 +385,14 @@ type CQLStats struct {
 	PreparedStatementsRatio    FloatGauge
 }
 
+// BatchStats embeds stats about the number of partitions touched per batch
+// statement for logged, unlogged and counter batches
+type BatchStats struct {
+	PartitionsPerLoggedBatch   Histogram
+	PartitionsPerUnloggedBatch Histogram
+	PartitionsPerCounterBatch  Histogram
+}
+
 // ThreadPoolStats embeds stats for a type of Thread Pool
 type ThreadPoolStats struct {
 	PoolName              string
@@ -158,20 +411,170 @@ type CompactionStats struct {
 	CompletedTasks Counter
 }
 
-// ClientRequestStats embeds stats for client requests
+// ActiveCompaction embeds the progress of running compactions for a table
+// and task type. If there are multiple compactions of the same type running
+// for a table, their progress is summed together
+type ActiveCompaction struct {
+	Table     Table
+	TaskType  string
+	Unit      string
+	Running   Gauge
+	Completed Gauge
+	Total     Gauge
+}
+
+// CompactionHistoryEntry embeds information about a single compaction which
+// has completed. The ID is unique for each compaction
+type CompactionHistoryEntry struct {
+	ID          string
+	Table       Table
+	CompactedAt time.Time
+	BytesIn     Counter
+	BytesOut    Counter
+}
+
+// ClientRequestStats embeds stats for client requests. From Cassandra 4.0,
+// requests are also broken down per consistency level in which case the
+// ConsistencyLevel will be set (otherwise it is empty)
 type ClientRequestStats struct {
-	RequestType    string
-	RequestLatency Latency
-	Timeouts       Counter
-	Failures       Counter
-	Unavailables   Counter
+	RequestType      string
+	ConsistencyLevel string
+	RequestLatency   Latency
+	Timeouts         Counter
+	Failures         Counter
+	Unavailables     Counter
+}
+
+// CASRequestStats embeds the extra stats Cassandra keeps for CAS client
+// requests on top of the regular client request stats. ConditionNotMet is
+// only tracked for writes, UnknownResult is only available on 4.0+ and the
+// Paxos repair meters are only available on 4.1+
+type CASRequestStats struct {
+	RequestType      string
+	Contention       Histogram
+	UnfinishedCommit Counter
+	ConditionNotMet  Counter
+	UnknownResult    Counter
+	PaxosRepairs     []PaxosRepairMeter
+}
+
+// PaxosRepairMeter embeds one of the meters Cassandra keeps about repairing
+// in-progress Paxos rounds. Name is the name of the meter in Cassandra
+type PaxosRepairMeter struct {
+	Name  string
+	Count Counter
+}
+
+// ClientConnectionStats embeds the number of connected clients grouped in
+// various ways. Users are available on all versions, the driver and protocol
+// version breakdowns need the client list from Cassandra 4.0+
+type ClientConnectionStats struct {
+	ByUser            []ClientConnectionGroup
+	ByDriver          []ClientConnectionGroup
+	ByProtocolVersion []ClientConnectionGroup
+}
+
+// ClientConnectionGroup embeds the number of connections for a group of
+// clients. The Version is only set when grouping by driver
+type ClientConnectionGroup struct {
+	Name        string
+	Version     string
+	Connections Gauge
+}
+
+// NativeTransportStats embeds stats about the Native Protocol server. Most
+// of these are only available from Cassandra 4.0 onwards
+type NativeTransportStats struct {
+	PausedConnections    Gauge
+	RequestsDiscarded    Counter
+	QueuedRequests       Gauge
+	RequestsSizeInFlight BytesGauge
+	RequestSize          Histogram
+}
+
+// AuthCacheStats embeds stats for a single auth cache
+type AuthCacheStats struct {
+	CacheName string
+	Hits      Counter
+	Misses    Counter
+	Size      Gauge
+	Capacity  Gauge
 }
 
 // MemoryStats embeds stats about Java memory such as how much
 // heap and off-heap memory is being utilised
 type MemoryStats struct {
-	HeapUsed    BytesGauge
-	NonHeapUsed BytesGauge
+	HeapUsed         BytesGauge
+	HeapCommitted    BytesGauge
+	HeapMax          BytesGauge
+	NonHeapUsed      BytesGauge
+	NonHeapCommitted BytesGauge
+}
+
+// JVMStats embeds information about the Java process. InputArgumentsHash is
+// a hash of the JVM arguments so that changes to them can be spotted without
+// exporting the arguments themselves. The threading, class loading and
+// compilation stats are best effort and are only set if the matching Has
+// field is true
+type JVMStats struct {
+	Vendor             string
+	Version            string
+	InputArgumentsHash string
+
+	StartTime time.Time
+	Uptime    time.Duration
+
+	HasThreads     bool
+	Threads        Gauge
+	DaemonThreads  Gauge
+	PeakThreads    Gauge
+	ThreadsStarted Counter
+
+	HasClasses      bool
+	LoadedClasses   Gauge
+	ClassesLoaded   Counter
+	ClassesUnloaded Counter
+
+	HasCompilationTime bool
+	CompilationTime    time.Duration
+}
+
+// MemoryPoolStats embeds information for each memory pool in the process.
+// Max is -1 if the pool has no maximum size and PostGCUsed is -1 if the pool
+// isn't managed by the garbage collector
+type MemoryPoolStats struct {
+	Name       string
+	Type       string // HEAP or NON_HEAP
+	Used       BytesGauge
+	Committed  BytesGauge
+	Max        BytesGauge
+	PostGCUsed BytesGauge
+}
+
+// BufferPoolStats embeds information for each buffer pool in the process
+type BufferPoolStats struct {
+	Name          string
+	Buffers       Gauge
+	Used          BytesGauge
+	TotalCapacity BytesGauge
+}
+
+// OperatingSystemStats embeds information about the Java process and the
+// machine it's running on. The CPU loads are between 0 and 1 and are -1 if
+// they aren't available yet
+type OperatingSystemStats struct {
+	OpenFileDescriptors Gauge
+	MaxFileDescriptors  Gauge
+	ProcessCPUTime      time.Duration
+	ProcessCPULoad      FloatGauge
+
+	SystemCPULoad       FloatGauge
+	SystemLoadAverage   FloatGauge
+	AvailableProcessors Gauge
+	FreePhysicalMemory  BytesGauge
+	TotalPhysicalMemory BytesGauge
+	FreeSwapSpace       BytesGauge
+	TotalSwapSpace      BytesGauge
 }
 
 // GCStats embeds information for each type of GC that occurs in the process
@@ -195,6 +598,28 @@ type StorageStats struct {
 	NodeEndpoints	 map[string]string
 }
 
+// SnapshotStats embeds information about the snapshots on disk. TrueSize is
+// the space taken up by the snapshots which isn't shared with live SSTables
+// and is nil if it couldn't be read. Tables and TableSizes are nil if they
+// couldn't be read
+type SnapshotStats struct {
+	TrueSize   *BytesGauge
+	Tables     []TableSnapshots
+	TableSizes []TableSnapshotsSize
+}
+
+// TableSnapshots embeds the number of snapshots which exist for a table
+type TableSnapshots struct {
+	Table     Table
+	Snapshots Gauge
+}
+
+// TableSnapshotsSize embeds the disk space used by the snapshots of a table
+type TableSnapshotsSize struct {
+	Table Table
+	Size  BytesGauge
+}
+
 // StorageCoreStats embeds information gathered from the Storage metric in
 // Cassandra such as the number of total hints and hints being handed off
 // and internal exceptions
@@ -204,3 +629,70 @@ type StorageCoreStats struct {
 	TotalHintsInProgress Gauge
 	TotalHints           Counter
 }
+
+// MessagingStats embeds information about the latency of internode messages.
+// Cassandra tracks this for every message it receives from other nodes and
+// also breaks it down by the datacenter the message originated from
+type MessagingStats struct {
+	CrossNodeLatency  Latency
+	DatacenterLatency []DatacenterLatency
+}
+
+// DatacenterLatency embeds the internode messaging latency for messages
+// received from a single datacenter
+type DatacenterLatency struct {
+	Datacenter string
+	Latency    Latency
+}
+
+// HintsStats embeds information gathered from the HintsService metrics in
+// Cassandra (4.0+) about whether hints are being delivered to other nodes
+type HintsStats struct {
+	Succeeded Counter
+	Failed    Counter
+	TimedOut  Counter
+	Delays    []EndpointHintDelay
+}
+
+// EndpointHintDelay embeds the distribution of how long hints have been
+// waiting before being delivered to a single endpoint (in milliseconds)
+type EndpointHintDelay struct {
+	Endpoint string
+	Delay    Histogram
+}
+
+// RepairStats embeds information about repair sessions. Sessions only
+// counts incremental repair sessions which haven't finished yet, Cassandra
+// doesn't keep track of full repair sessions in the same way
+type RepairStats struct {
+	Sessions        []RepairSessionState
+	PreviewFailures Counter
+}
+
+// RepairSessionState embeds the number of repair sessions in a given state
+// such as PREPARING or REPAIRING
+type RepairSessionState struct {
+	State    string
+	Sessions Gauge
+}
+
+// ViewWriteStats embeds information about materialized view updates which
+// were coordinated by this node. PendingMutations is the number of view
+// updates which have been sent but not yet acknowledged
+type ViewWriteStats struct {
+	ReplicasAttempted Counter
+	ReplicasSuccess   Counter
+	PendingMutations  Gauge
+	WriteLatency      Latency
+}
+
+// ReadRepairStats embeds information about read repairs. The speculated and
+// reconcile counters are only available from Cassandra 4.0 onwards
+type ReadRepairStats struct {
+	RepairedBlocking   Counter
+	RepairedBackground Counter
+	Attempted          Counter
+	SpeculatedRead     Counter
+	SpeculatedWrite    Counter
+	ReconcileRead      Counter
+}
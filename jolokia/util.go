@@ -1,6 +1,10 @@
 package jolokia
 
 import (
+	"hash/fnv"
+	"math"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -72,6 +76,139 @@ func parseLatency(val *fastjson.Value) Latency {
 	}
 }
 
+// parseBucketedHistogram takes the raw bucket counts of one of Cassandra's
+// estimated histograms and pairs them up with the bucket offsets. Cassandra
+// doesn't expose the offsets but they are always generated the same way so
+// we can work them out from the number of buckets
+//
+//   [0, 0, 3, 10, 2, ..., 0]
+//
+func parseBucketedHistogram(val *fastjson.Value) BucketedHistogram {
+	values := val.GetArray()
+	if len(values) == 0 {
+		return BucketedHistogram{}
+	}
+
+	buckets := make([]Counter, 0, len(values))
+	for _, v := range values {
+		buckets = append(buckets, Counter(v.GetInt64()))
+	}
+
+	return BucketedHistogram{
+		Offsets: estimatedHistogramOffsets(len(values) - 1),
+		Buckets: buckets,
+	}
+}
+
+// maxSSTableWindows is how many of the newest time windows we keep for a
+// table using TimeWindowCompactionStrategy
+const maxSSTableWindows = 24
+
+// parseSSTablesPerWindow takes the SSTable count of each time window keyed by
+// the start of the window (as a unix timestamp in milliseconds) and indexes
+// the windows from newest to oldest. The timestamps keep changing so we don't
+// use them directly and any windows past maxSSTableWindows are summed into
+// the last one we keep
+//
+//   {"1700000000000": 4, "1700086400000": 1}
+//
+func parseSSTablesPerWindow(val *fastjson.Value) []WindowSSTables {
+	type window struct {
+		start    int64
+		sstables Gauge
+	}
+
+	windows := []window{}
+	val.GetObject().Visit(func(key []byte, v *fastjson.Value) {
+		start, err := strconv.ParseInt(string(key), 10, 64)
+		if err != nil {
+			return
+		}
+		windows = append(windows, window{start: start, sstables: Gauge(v.GetInt64())})
+	})
+	if len(windows) == 0 {
+		return nil
+	}
+	sort.Slice(windows, func(i, j int) bool { return windows[i].start > windows[j].start })
+
+	out := make([]WindowSSTables, 0, maxSSTableWindows)
+	for idx, w := range windows {
+		if idx >= maxSSTableWindows {
+			out[maxSSTableWindows-1].SSTables += w.sstables
+			continue
+		}
+		out = append(out, WindowSSTables{Window: idx, SSTables: w.sstables})
+	}
+	return out
+}
+
+// estimatedHistogramOffsets generates the bucket offsets in the same way as
+// Cassandra's EstimatedHistogram. Each offset is roughly 20% larger than the
+// previous one, starting from 1
+func estimatedHistogramOffsets(size int) []int64 {
+	if size <= 0 {
+		return []int64{}
+	}
+
+	out := make([]int64, size)
+	out[0] = 1
+	for i := 1; i < size; i++ {
+		next := int64(math.Round(float64(out[i-1]) * 1.2))
+		if next == out[i-1] {
+			next++
+		}
+		out[i] = next
+	}
+	return out
+}
+
+// Count returns the total number of values recorded in the histogram
+func (h BucketedHistogram) Count() uint64 {
+	var count uint64
+	for _, bucket := range h.Buckets {
+		count += uint64(bucket)
+	}
+	return count
+}
+
+// Sum returns an estimate of the sum of all the values in the histogram.
+// Like Cassandra, we assume each value is the upper bound of its bucket and
+// values in the overflow bucket are treated as the last offset
+func (h BucketedHistogram) Sum() float64 {
+	if len(h.Offsets) == 0 {
+		return 0
+	}
+
+	var sum float64
+	for i, bucket := range h.Buckets {
+		offset := h.Offsets[len(h.Offsets)-1]
+		if i < len(h.Offsets) {
+			offset = h.Offsets[i]
+		}
+		sum += float64(bucket) * float64(offset)
+	}
+	return sum
+}
+
+// CumulativeCounts returns the number of values less than or equal to each
+// of the given upper bounds. Since Cassandra's buckets don't line up with the
+// bounds, only buckets which fit entirely within a bound are counted so the
+// counts may be slightly underestimated
+func (h BucketedHistogram) CumulativeCounts(bounds []float64) map[float64]uint64 {
+	out := make(map[float64]uint64, len(bounds))
+	for _, bound := range bounds {
+		var count uint64
+		for i, offset := range h.Offsets {
+			if float64(offset) > bound || i >= len(h.Buckets) {
+				break
+			}
+			count += uint64(h.Buckets[i])
+		}
+		out[bound] = count
+	}
+	return out
+}
+
 func parseDurationString(in string) time.Duration {
 	switch strings.ToLower(in) {
 	case "nanosecond", "nanoseconds", "ns", "nsec":
@@ -119,6 +256,150 @@ func extractAttributes(tag string) map[string]string {
 	return out
 }
 
+// consistencyLevels is the set of consistency levels Cassandra may use as a
+// suffix on client request scopes
+var consistencyLevels = map[string]struct{}{
+	"ANY":          {},
+	"ONE":          {},
+	"TWO":          {},
+	"THREE":        {},
+	"QUORUM":       {},
+	"ALL":          {},
+	"LOCAL_QUORUM": {},
+	"EACH_QUORUM":  {},
+	"SERIAL":       {},
+	"LOCAL_SERIAL": {},
+	"LOCAL_ONE":    {},
+	"NODE_LOCAL":   {},
+}
+
+// parseRequestScope splits a client request scope into the request type and
+// consistency level. From Cassandra 4.0, scopes can be suffixed with the
+// consistency level. If there is no consistency level, it is left empty
+//
+// example: Read-LOCAL_QUORUM
+// turns into:
+//   requestType:      "Read"
+//   consistencyLevel: "LOCAL_QUORUM"
+//
+func parseRequestScope(scope string) (string, string) {
+	idx := strings.LastIndexByte(scope, '-')
+	if idx < 0 {
+		return scope, ""
+	}
+
+	if _, ok := consistencyLevels[scope[idx+1:]]; !ok {
+		return scope, ""
+	}
+	return scope[:idx], scope[idx+1:]
+}
+
+// parseHintEndpoint turns the endpoint of a Hint_delays-<endpoint> metric
+// back into the address of the node. Cassandra 4.0+ includes the port and
+// replaces any colons with dots to keep the JMX name valid, and some versions
+// prefix the address with a slash (and maybe a hostname). We drop the port so
+// the endpoint matches the node labels we export elsewhere
+//
+// example: /10.0.0.1.7000
+// turns into:
+//   10.0.0.1
+//
+func parseHintEndpoint(endpoint string) string {
+	if idx := strings.LastIndexByte(endpoint, '/'); idx >= 0 {
+		endpoint = endpoint[idx+1:]
+	}
+
+	// IPv6 addresses are wrapped in brackets when the port is included
+	if strings.HasPrefix(endpoint, "[") {
+		if idx := strings.IndexByte(endpoint, ']'); idx > 0 {
+			return strings.ReplaceAll(endpoint[1:idx], ".", ":")
+		}
+	}
+
+	// An IPv4 address has four parts so a fifth one is the port
+	if parts := strings.Split(endpoint, "."); len(parts) == 5 {
+		return strings.Join(parts[:4], ".")
+	}
+	return endpoint
+}
+
+// stringOrDefault returns the string value of a fastjson value or the
+// fallback if the value is missing or an empty string
+func stringOrDefault(val *fastjson.Value, fallback string) string {
+	if str := string(val.GetStringBytes()); str != "" {
+		return str
+	}
+	return fallback
+}
+
+// shortClassName strips the package from a Java class name so that
+// org.apache.cassandra.db.compaction.LeveledCompactionStrategy becomes
+// LeveledCompactionStrategy
+func shortClassName(name string) string {
+	return name[strings.LastIndexByte(name, '.')+1:]
+}
+
+// parseCompression takes in the compression parameters of a table and
+// returns the short class name of the compressor, or "none" if compression
+// is disabled. Older versions of Cassandra use sstable_compression rather
+// than class for the compressor
+func parseCompression(params map[string]string) string {
+	if params["enabled"] == "false" {
+		return "none"
+	}
+
+	class := params["class"]
+	if class == "" {
+		class = params["sstable_compression"]
+	}
+	if class == "" {
+		return "none"
+	}
+	return shortClassName(class)
+}
+
+// tabularRows takes in a JMX TabularData value and returns each of the rows.
+// Jolokia serializes TabularData as nested objects keyed by each of the index
+// columns, or as an array of rows if it can't. To handle both, we look for
+// any objects which have the given column in them
+//
+//   {"<id>": {"id": "<id>", "keyspace_name": "system", ...}, ...}
+//   {"<name>": {"<keyspace>": {"<table>": {"name": "<name>", ...}}}}
+//   [{"id": "<id>", "keyspace_name": "system", ...}, ...]
+//
+func tabularRows(val *fastjson.Value, column string) []*fastjson.Value {
+	out := []*fastjson.Value{}
+	if val == nil {
+		return out
+	}
+
+	switch val.Type() {
+	case fastjson.TypeArray:
+		for _, item := range val.GetArray() {
+			out = append(out, tabularRows(item, column)...)
+		}
+	case fastjson.TypeObject:
+		if val.Exists(column) {
+			return append(out, val)
+		}
+		val.GetObject().Visit(func(_ []byte, item *fastjson.Value) {
+			out = append(out, tabularRows(item, column)...)
+		})
+	}
+	return out
+}
+
+// hashStrings returns a short hex hash of a list of strings. It's used to
+// track changes to a list (such as JVM arguments) without exporting it
+func hashStrings(in []string) string {
+	h := fnv.New64a()
+	for _, str := range in {
+		h.Write([]byte(str))
+		h.Write([]byte{0})
+	}
+	return strconv.FormatUint(h.Sum64(), 16)
+}
+
 // valueToStringArray takes in an array of fastjson value types
 // and converts the ones which are a string value to output an
 // array of strings
@@ -1,10 +1,14 @@
 package jolokia
 
 import (
+	"encoding/json"
+	"strconv"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valyala/fastjson"
 )
 
 func TestParseDurationString(t *testing.T) {
@@ -48,3 +52,145 @@ func TestExtractAttributes(t *testing.T) {
 	attr3 := extractAttributes("org.apache.cassandra.metrics")
 	assert.Equal(t, map[string]string{}, attr3)
 }
+
+func TestParseRequestScope(t *testing.T) {
+	cases := []struct {
+		in               string
+		requestType      string
+		consistencyLevel string
+	}{
+		{in: "Read", requestType: "Read", consistencyLevel: ""},
+		{in: "CASWrite", requestType: "CASWrite", consistencyLevel: ""},
+		{in: "Read-LOCAL_QUORUM", requestType: "Read", consistencyLevel: "LOCAL_QUORUM"},
+		{in: "Write-ONE", requestType: "Write", consistencyLevel: "ONE"},
+		{in: "RangeSlice-ALL", requestType: "RangeSlice", consistencyLevel: "ALL"},
+		{in: "Read-Unknown", requestType: "Read-Unknown", consistencyLevel: ""},
+	}
+
+	for _, tc := range cases {
+		requestType, consistencyLevel := parseRequestScope(tc.in)
+		assert.Equal(t, tc.requestType, requestType)
+		assert.Equal(t, tc.consistencyLevel, consistencyLevel)
+	}
+}
+
+func TestParseHintEndpoint(t *testing.T) {
+	cases := []struct {
+		in  string
+		out string
+	}{
+		{in: "/10.0.0.1.7000", out: "10.0.0.1"},
+		{in: "10.0.0.1.7000", out: "10.0.0.1"},
+		{in: "/10.0.0.1", out: "10.0.0.1"},
+		{in: "cassandra-1/10.0.0.1", out: "10.0.0.1"},
+		{in: "/[2001.db8..1].7000", out: "2001:db8::1"},
+	}
+
+	for _, tc := range cases {
+		assert.Equal(t, tc.out, parseHintEndpoint(tc.in))
+	}
+}
+
+func TestShortClassName(t *testing.T) {
+	assert.Equal(t, "LeveledCompactionStrategy", shortClassName("org.apache.cassandra.db.compaction.LeveledCompactionStrategy"))
+	assert.Equal(t, "LZ4Compressor", shortClassName("LZ4Compressor"))
+	assert.Equal(t, "", shortClassName(""))
+}
+
+func TestParseCompression(t *testing.T) {
+	cases := []struct {
+		in  map[string]string
+		out string
+	}{
+		{in: map[string]string{"chunk_length_in_kb": "16", "class": "org.apache.cassandra.io.compress.LZ4Compressor"}, out: "LZ4Compressor"},
+		{in: map[string]string{"sstable_compression": "org.apache.cassandra.io.compress.SnappyCompressor"}, out: "SnappyCompressor"},
+		{in: map[string]string{"enabled": "false"}, out: "none"},
+		{in: map[string]string{}, out: "none"},
+	}
+
+	for _, tc := range cases {
+		assert.Equal(t, tc.out, parseCompression(tc.in))
+	}
+}
+
+func TestHashStrings(t *testing.T) {
+	hash := hashStrings([]string{"-Xms8G", "-Xmx8G"})
+	assert.Equal(t, hash, hashStrings([]string{"-Xms8G", "-Xmx8G"}))
+	assert.NotEqual(t, hash, hashStrings([]string{"-Xms8G", "-Xmx16G"}))
+	assert.NotEqual(t, hashStrings([]string{"ab", "c"}), hashStrings([]string{"a", "bc"}))
+}
+
+func TestTabularRows(t *testing.T) {
+	keyed, err := fastjson.Parse(`{"a": {"id": "a", "bytes_in": 10}, "b": {"id": "b", "bytes_in": 20}}`)
+	require.NoError(t, err)
+	rows := tabularRows(keyed, "id")
+	require.Len(t, rows, 2)
+	assert.Equal(t, "a", string(rows[0].GetStringBytes("id")))
+	assert.Equal(t, int64(20), rows[1].GetInt64("bytes_in"))
+
+	nested, err := fastjson.Parse(`{"snap1": {"ks": {"t1": {"name": "snap1"}, "t2": {"name": "snap1"}}}, "snap2": {"ks": {"t1": {"name": "snap2"}}}}`)
+	require.NoError(t, err)
+	assert.Len(t, tabularRows(nested, "name"), 3)
+
+	list, err := fastjson.Parse(`[{"id": "a"}, {"id": "b"}, {"id": "c"}]`)
+	require.NoError(t, err)
+	assert.Len(t, tabularRows(list, "id"), 3)
+
+	empty, err := fastjson.Parse(`null`)
+	require.NoError(t, err)
+	assert.Len(t, tabularRows(empty, "id"), 0)
+	assert.Len(t, tabularRows(nil, "id"), 0)
+}
+
+func TestEstimatedHistogramOffsets(t *testing.T) {
+	assert.Equal(t, []int64{}, estimatedHistogramOffsets(0))
+	assert.Equal(t, []int64{1, 2, 3, 4, 5, 6, 7, 8, 10, 12, 14, 17, 20, 24, 29, 35}, estimatedHistogramOffsets(16))
+}
+
+func TestParseBucketedHistogram(t *testing.T) {
+	val, err := fastjson.Parse(`[0, 2, 0, 3, 1, 0]`)
+	require.NoError(t, err)
+
+	h := parseBucketedHistogram(val)
+	assert.Equal(t, []int64{1, 2, 3, 4, 5}, h.Offsets)
+	assert.Equal(t, []Counter{0, 2, 0, 3, 1, 0}, h.Buckets)
+	assert.Equal(t, uint64(6), h.Count())
+	assert.Equal(t, float64(2*2+3*4+1*5), h.Sum())
+	assert.Equal(t, map[float64]uint64{
+		1:   0,
+		2.5: 2,
+		4:   5,
+		100: 6,
+	}, h.CumulativeCounts([]float64{1, 2.5, 4, 100}))
+
+	empty, err := fastjson.Parse(`[]`)
+	require.NoError(t, err)
+	assert.Equal(t, BucketedHistogram{}, parseBucketedHistogram(empty))
+}
+
+func TestParseSSTablesPerWindow(t *testing.T) {
+	val, err := fastjson.Parse(`{"1700000000000": 4, "1700172800000": 1, "1700086400000": 2, "bad": 9}`)
+	require.NoError(t, err)
+	assert.Equal(t, []WindowSSTables{
+		{Window: 0, SSTables: 1},
+		{Window: 1, SSTables: 2},
+		{Window: 2, SSTables: 4},
+	}, parseSSTablesPerWindow(val))
+
+	// Windows past the cap are summed into the oldest window we keep
+	windows := map[string]int{}
+	for i := 0; i < maxSSTableWindows+5; i++ {
+		windows[strconv.Itoa(1700000000000+i*86400000)] = 1
+	}
+	raw, err := json.Marshal(windows)
+	require.NoError(t, err)
+	val, err = fastjson.ParseBytes(raw)
+	require.NoError(t, err)
+
+	out := parseSSTablesPerWindow(val)
+	require.Len(t, out, maxSSTableWindows)
+	assert.Equal(t, WindowSSTables{Window: 0, SSTables: 1}, out[0])
+	assert.Equal(t, WindowSSTables{Window: maxSSTableWindows - 1, SSTables: 6}, out[maxSSTableWindows-1])
+
+	assert.Nil(t, parseSSTablesPerWindow(nil))
+}
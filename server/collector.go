@@ -2,8 +2,18 @@ package server
 
 import (
 	"sort"
+	"strconv"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/suhailpatel/seastat/jolokia"
+)
+
+// Cassandra keeps well over 100 buckets for its partition size and cell count
+// histograms. We only export a handful of them to keep the number of series
+// per table down
+var (
+	partitionSizeBuckets = []float64{1e2, 1e3, 1e4, 1e5, 1e6, 1e7, 1e8, 1e9}
+	cellCountBuckets     = []float64{1, 10, 1e2, 1e3, 1e4, 1e5, 1e6}
 )
 
 // SeastatCollector is here to satisfy the Prometheus Collector interface
@@ -35,10 +45,13 @@ func (c *SeastatCollector) Describe(ch chan<- *prometheus.Desc) {
 		PromTableRangeScan,
 		PromTableCASPropose,
 		PromTableCASCommit,
+		PromTableViewLockAcquireTime,
+		PromTableViewReadTime,
 		PromTableEstimatedPartitionCount,
 		PromTablePendingCompactions,
 		PromTableLiveDiskSpaceUsed,
 		PromTableTotalDiskSpaceUsed,
+		PromTableSnapshotsSize,
 		PromTableLiveSSTables,
 		PromTableMaxPartitionSize,
 		PromTableMeanPartitionSize,
@@ -49,6 +62,72 @@ func (c *SeastatCollector) Describe(ch chan<- *prometheus.Desc) {
 		PromTablePercentRepaired,
 		PromTableSpeculativeRetries,
 		PromTableSpeculativeFailedRetries,
+		PromTableReadRepairRequests,
+		PromTableShortReadProtectionRequests,
+		PromTableDroppedMutations,
+		PromTableRowCacheHits,
+		PromTableRowCacheMisses,
+		PromTableRowCacheHitsOutOfRange,
+		PromTableTombstoneWarnings,
+		PromTableTombstoneFailures,
+		PromTableBytesRepaired,
+		PromTableBytesUnrepaired,
+		PromTableBytesPendingRepair,
+		PromTableRepairedDataInconsistenciesConfirmed,
+		PromTableRepairedDataInconsistenciesUnconfirmed,
+		PromTableAnticompactionTime,
+		PromTableValidationTime,
+		PromTableRepairSyncTime,
+		PromTableMemtableLiveDataSize,
+		PromTableMemtableOnHeapSize,
+		PromTableMemtableOffHeapSize,
+		PromTableMemtableColumns,
+		PromTableMemtableSwitches,
+		PromTablePendingFlushes,
+		PromTableAllMemtablesLiveDataSize,
+		PromTableAllMemtablesOnHeapSize,
+		PromTableAllMemtablesOffHeapSize,
+		PromTableBloomFilterOffHeapMemoryUsed,
+		PromTableIndexSummaryOffHeapMemoryUsed,
+		PromTableCompressionMetadataOffHeapMemoryUsed,
+		PromTableBloomFilterDiskSpaceUsed,
+		PromTablePartitionSize,
+		PromTableCellCount,
+		PromTableSSTablesPerLevel,
+		PromTableSSTablesPerWindow,
+		PromTableInfo,
+		PromTableCompressionChunkLength,
+		PromTableMinCompactionThreshold,
+		PromTableMaxCompactionThreshold,
+		PromTableDroppableTombstoneRatio,
+
+		// KeyspaceStats
+		PromKeyspaceRead,
+		PromKeyspaceWrite,
+		PromKeyspaceRangeScan,
+		PromKeyspaceLiveDiskSpaceUsed,
+		PromKeyspaceTotalDiskSpaceUsed,
+		PromKeyspacePendingCompactions,
+		PromKeyspacePendingFlushes,
+
+		// IndexStats
+		PromIndexInfo,
+		PromIndexBuilt,
+		PromIndexLiveDiskSpaceUsed,
+		PromIndexMemtableSize,
+		PromIndexQueryLatency,
+		PromIndexTotalDiskSpaceUsed,
+		PromIndexLiveSSTables,
+		PromIndexSSTableCells,
+		PromIndexMemtableIndexWrites,
+		PromIndexMemtableIndexWriteLatency,
+		PromIndexMemtableIndexFlushes,
+		PromIndexCompactions,
+		PromIndexSegmentsPerCompaction,
+
+		// MemtablePoolStats
+		PromMemtablePoolBlockedOnAllocation,
+		PromMemtablePoolWaitingOnFreeSpace,
 
 		// CQLStats
 		PromCQLPreparedStatementsCount,
@@ -57,6 +136,11 @@ func (c *SeastatCollector) Describe(ch chan<- *prometheus.Desc) {
 		PromCQLRegularStatementsExecuted,
 		PromCQLPreparedStatementsRatio,
 
+		// BatchStats
+		PromBatchPartitionsPerLoggedBatch,
+		PromBatchPartitionsPerUnloggedBatch,
+		PromBatchPartitionsPerCounterBatch,
+
 		// ThreadPoolStats
 		PromThreadPoolActiveTasks,
 		PromThreadPoolPendingTasks,
@@ -70,12 +154,88 @@ func (c *SeastatCollector) Describe(ch chan<- *prometheus.Desc) {
 		PromCompactionPendingTasks,
 		PromCompactionCompletedTasks,
 
+		// ActiveCompactions
+		PromCompactionsRunning,
+		PromActiveCompactionRunning,
+		PromActiveCompactionCompleted,
+		PromActiveCompactionTotal,
+
+		// CompactionHistory
+		PromCompactionHistoryCompactions,
+		PromCompactionHistoryBytesIn,
+		PromCompactionHistoryBytesOut,
+
+		// CASRequestStats
+		PromCASRequestContention,
+		PromCASRequestUnfinishedCommit,
+		PromCASRequestConditionNotMet,
+		PromCASRequestUnknownResult,
+		PromCASRequestPaxosRepairs,
+
 		// ConnectedClients
 		PromConnectedClients,
 
+		// ClientConnectionStats
+		PromClientConnectionsByUser,
+		PromClientConnectionsByDriver,
+		PromClientConnectionsByProtocolVersion,
+
+		// NativeTransportStats
+		PromNativeTransportPausedConnections,
+		PromNativeTransportRequestsDiscarded,
+		PromNativeTransportQueuedRequests,
+		PromNativeTransportRequestsInFlight,
+		PromNativeTransportRequestSize,
+
+		// AuthCacheStats
+		PromAuthCacheHits,
+		PromAuthCacheMisses,
+		PromAuthCacheSize,
+		PromAuthCacheCapacity,
+
 		// MemoryStats
 		PromMemoryStatsHeapUsed,
+		PromMemoryStatsHeapCommitted,
+		PromMemoryStatsHeapMax,
 		PromMemoryStatsNonHeapUsed,
+		PromMemoryStatsNonHeapCommitted,
+
+		// JVMStats
+		PromJVMInfo,
+		PromJVMStartTime,
+		PromJVMUptime,
+		PromJVMThreads,
+		PromJVMDaemonThreads,
+		PromJVMPeakThreads,
+		PromJVMThreadsStarted,
+		PromJVMLoadedClasses,
+		PromJVMClassesLoaded,
+		PromJVMClassesUnloaded,
+		PromJVMCompilationTime,
+
+		// MemoryPoolStats
+		PromMemoryPoolUsed,
+		PromMemoryPoolCommitted,
+		PromMemoryPoolMax,
+		PromMemoryPoolPostGCUsed,
+
+		// BufferPoolStats
+		PromBufferPoolBuffers,
+		PromBufferPoolUsed,
+		PromBufferPoolCapacity,
+
+		// OperatingSystemStats
+		PromProcessOpenFDs,
+		PromProcessMaxFDs,
+		PromProcessCPUTime,
+		PromProcessCPULoad,
+		PromOSCPULoad,
+		PromOSLoadAverage,
+		PromOSAvailableProcessors,
+		PromOSMemoryFree,
+		PromOSMemoryTotal,
+		PromOSSwapFree,
+		PromOSSwapTotal,
 
 		// GCStats
 		PromGCStatsCountTotal,
@@ -88,9 +248,41 @@ func (c *SeastatCollector) Describe(ch chan<- *prometheus.Desc) {
 		PromStorageNodeStatus,
 		PromStorageNodeEndpointID,
 
+		// SnapshotStats
+		PromSnapshotsTrueSize,
+		PromTableSnapshots,
+
 		// StorageCoreStats
 		PromTotalHintsInProgress,
 		PromTotalHints,
+
+		// MessagingStats
+		PromMessagingCrossNodeLatency,
+		PromMessagingDatacenterLatency,
+
+		// HintsStats
+		PromHintsSucceeded,
+		PromHintsFailed,
+		PromHintsTimedOut,
+		PromHintsDelay,
+
+		// ReadRepairStats
+		PromReadRepairRepairedBlocking,
+		PromReadRepairRepairedBackground,
+		PromReadRepairAttempted,
+		PromReadRepairSpeculatedRead,
+		PromReadRepairSpeculatedWrite,
+		PromReadRepairReconcileRead,
+
+		// RepairStats
+		PromRepairSessions,
+		PromRepairPreviewFailures,
+
+		// ViewWriteStats
+		PromViewWriteReplicasAttempted,
+		PromViewWriteReplicasSuccess,
+		PromViewWritePendingMutations,
+		PromViewWriteLatency,
 	}
 
 	for _, desc := range descs {
@@ -111,15 +303,35 @@ func (c *SeastatCollector) Collect(ch chan<- prometheus.Metric) {
 		prometheus.GaugeValue, float64(metrics.ScrapeDuration.Seconds()))
 
 	addTableStats(metrics, ch)
+	addKeyspaceStats(metrics, ch)
+	addIndexStats(metrics, ch)
+	addMemtablePoolStats(metrics, ch)
 	addCQLStats(metrics, ch)
+	addBatchStats(metrics, ch)
 	addThreadPoolStats(metrics, ch)
 	addCompactionStats(metrics, ch)
+	addActiveCompactions(metrics, ch)
+	addCompactionHistory(metrics, ch)
 	addClientRequestStats(metrics, ch)
+	addCASRequestStats(metrics, ch)
 	addConnectedClientStats(metrics, ch)
+	addClientConnectionStats(metrics, ch)
+	addNativeTransportStats(metrics, ch)
+	addAuthCacheStats(metrics, ch)
 	addMemoryStats(metrics, ch)
+	addJVMStats(metrics, ch)
+	addMemoryPoolStats(metrics, ch)
+	addBufferPoolStats(metrics, ch)
+	addOperatingSystemStats(metrics, ch)
 	addGCStats(metrics, ch)
 	addStorageStats(metrics, ch)
+	addSnapshotStats(metrics, ch)
 	addStorageCoreStats(metrics, ch)
+	addMessagingStats(metrics, ch)
+	addHintsStats(metrics, ch)
+	addRepairStats(metrics, ch)
+	addReadRepairStats(metrics, ch)
+	addViewWriteStats(metrics, ch)
 }
 
 func addTableStats(metrics ScrapedMetrics, ch chan<- prometheus.Metric) {
@@ -197,6 +409,24 @@ func addTableStats(metrics ScrapedMetrics, ch chan<- prometheus.Metric) {
 				99.0: stat.CASCommitLatency.Percentile99.Seconds(),
 				99.9: stat.CASCommitLatency.Percentile999.Seconds(),
 			}, stat.Table.KeyspaceName, stat.Table.TableName)
+		ch <- prometheus.MustNewConstSummary(PromTableViewLockAcquireTime,
+			uint64(stat.ViewLockAcquireTime.Count),
+			float64(stat.ViewLockAcquireTime.Count)*stat.ViewLockAcquireTime.Mean.Seconds(),
+			map[float64]float64{
+				75.0: stat.ViewLockAcquireTime.Percentile75.Seconds(),
+				95.0: stat.ViewLockAcquireTime.Percentile95.Seconds(),
+				99.0: stat.ViewLockAcquireTime.Percentile99.Seconds(),
+				99.9: stat.ViewLockAcquireTime.Percentile999.Seconds(),
+			}, stat.Table.KeyspaceName, stat.Table.TableName)
+		ch <- prometheus.MustNewConstSummary(PromTableViewReadTime,
+			uint64(stat.ViewReadTime.Count),
+			float64(stat.ViewReadTime.Count)*stat.ViewReadTime.Mean.Seconds(),
+			map[float64]float64{
+				75.0: stat.ViewReadTime.Percentile75.Seconds(),
+				95.0: stat.ViewReadTime.Percentile95.Seconds(),
+				99.0: stat.ViewReadTime.Percentile99.Seconds(),
+				99.9: stat.ViewReadTime.Percentile999.Seconds(),
+			}, stat.Table.KeyspaceName, stat.Table.TableName)
 
 		ch <- prometheus.MustNewConstMetric(PromTableEstimatedPartitionCount,
 			prometheus.GaugeValue, float64(stat.EstimatedPartitionCount),
@@ -264,7 +494,292 @@ func addTableStats(metrics ScrapedMetrics, ch chan<- prometheus.Metric) {
 		ch <- prometheus.MustNewConstMetric(PromTableCompressionRatio,
 			prometheus.GaugeValue, float64(stat.CompressionRatio),
 			stat.Table.KeyspaceName, stat.Table.TableName)
+		ch <- prometheus.MustNewConstMetric(PromTableReadRepairRequests,
+			prometheus.CounterValue, float64(stat.ReadRepairRequests),
+			stat.Table.KeyspaceName, stat.Table.TableName)
+		ch <- prometheus.MustNewConstMetric(PromTableShortReadProtectionRequests,
+			prometheus.CounterValue, float64(stat.ShortReadProtectionRequests),
+			stat.Table.KeyspaceName, stat.Table.TableName)
+		ch <- prometheus.MustNewConstMetric(PromTableDroppedMutations,
+			prometheus.CounterValue, float64(stat.DroppedMutations),
+			stat.Table.KeyspaceName, stat.Table.TableName)
+		ch <- prometheus.MustNewConstMetric(PromTableRowCacheHits,
+			prometheus.CounterValue, float64(stat.RowCacheHits),
+			stat.Table.KeyspaceName, stat.Table.TableName)
+		ch <- prometheus.MustNewConstMetric(PromTableRowCacheMisses,
+			prometheus.CounterValue, float64(stat.RowCacheMisses),
+			stat.Table.KeyspaceName, stat.Table.TableName)
+		ch <- prometheus.MustNewConstMetric(PromTableRowCacheHitsOutOfRange,
+			prometheus.CounterValue, float64(stat.RowCacheHitsOutOfRange),
+			stat.Table.KeyspaceName, stat.Table.TableName)
+		ch <- prometheus.MustNewConstMetric(PromTableTombstoneWarnings,
+			prometheus.CounterValue, float64(stat.TombstoneWarnings),
+			stat.Table.KeyspaceName, stat.Table.TableName)
+		ch <- prometheus.MustNewConstMetric(PromTableTombstoneFailures,
+			prometheus.CounterValue, float64(stat.TombstoneFailures),
+			stat.Table.KeyspaceName, stat.Table.TableName)
+
+		ch <- prometheus.MustNewConstMetric(PromTableBytesRepaired,
+			prometheus.GaugeValue, float64(stat.BytesRepaired),
+			stat.Table.KeyspaceName, stat.Table.TableName)
+		ch <- prometheus.MustNewConstMetric(PromTableBytesUnrepaired,
+			prometheus.GaugeValue, float64(stat.BytesUnrepaired),
+			stat.Table.KeyspaceName, stat.Table.TableName)
+		ch <- prometheus.MustNewConstMetric(PromTableBytesPendingRepair,
+			prometheus.GaugeValue, float64(stat.BytesPendingRepair),
+			stat.Table.KeyspaceName, stat.Table.TableName)
+		ch <- prometheus.MustNewConstMetric(PromTableRepairedDataInconsistenciesConfirmed,
+			prometheus.CounterValue, float64(stat.RepairedDataInconsistenciesConfirmed),
+			stat.Table.KeyspaceName, stat.Table.TableName)
+		ch <- prometheus.MustNewConstMetric(PromTableRepairedDataInconsistenciesUnconfirmed,
+			prometheus.CounterValue, float64(stat.RepairedDataInconsistenciesUnconfirmed),
+			stat.Table.KeyspaceName, stat.Table.TableName)
+		ch <- prometheus.MustNewConstSummary(PromTableAnticompactionTime,
+			uint64(stat.AnticompactionTime.Count),
+			float64(stat.AnticompactionTime.Count)*stat.AnticompactionTime.Mean.Seconds(),
+			map[float64]float64{
+				75.0: stat.AnticompactionTime.Percentile75.Seconds(),
+				95.0: stat.AnticompactionTime.Percentile95.Seconds(),
+				99.0: stat.AnticompactionTime.Percentile99.Seconds(),
+				99.9: stat.AnticompactionTime.Percentile999.Seconds(),
+			}, stat.Table.KeyspaceName, stat.Table.TableName)
+		ch <- prometheus.MustNewConstSummary(PromTableValidationTime,
+			uint64(stat.ValidationTime.Count),
+			float64(stat.ValidationTime.Count)*stat.ValidationTime.Mean.Seconds(),
+			map[float64]float64{
+				75.0: stat.ValidationTime.Percentile75.Seconds(),
+				95.0: stat.ValidationTime.Percentile95.Seconds(),
+				99.0: stat.ValidationTime.Percentile99.Seconds(),
+				99.9: stat.ValidationTime.Percentile999.Seconds(),
+			}, stat.Table.KeyspaceName, stat.Table.TableName)
+		ch <- prometheus.MustNewConstSummary(PromTableRepairSyncTime,
+			uint64(stat.RepairSyncTime.Count),
+			float64(stat.RepairSyncTime.Count)*stat.RepairSyncTime.Mean.Seconds(),
+			map[float64]float64{
+				75.0: stat.RepairSyncTime.Percentile75.Seconds(),
+				95.0: stat.RepairSyncTime.Percentile95.Seconds(),
+				99.0: stat.RepairSyncTime.Percentile99.Seconds(),
+				99.9: stat.RepairSyncTime.Percentile999.Seconds(),
+			}, stat.Table.KeyspaceName, stat.Table.TableName)
+
+		ch <- prometheus.MustNewConstMetric(PromTableMemtableLiveDataSize,
+			prometheus.GaugeValue, float64(stat.MemtableLiveDataSize),
+			stat.Table.KeyspaceName, stat.Table.TableName)
+		ch <- prometheus.MustNewConstMetric(PromTableMemtableOnHeapSize,
+			prometheus.GaugeValue, float64(stat.MemtableOnHeapSize),
+			stat.Table.KeyspaceName, stat.Table.TableName)
+		ch <- prometheus.MustNewConstMetric(PromTableMemtableOffHeapSize,
+			prometheus.GaugeValue, float64(stat.MemtableOffHeapSize),
+			stat.Table.KeyspaceName, stat.Table.TableName)
+		ch <- prometheus.MustNewConstMetric(PromTableMemtableColumns,
+			prometheus.GaugeValue, float64(stat.MemtableColumnsCount),
+			stat.Table.KeyspaceName, stat.Table.TableName)
+		ch <- prometheus.MustNewConstMetric(PromTableMemtableSwitches,
+			prometheus.CounterValue, float64(stat.MemtableSwitchCount),
+			stat.Table.KeyspaceName, stat.Table.TableName)
+		ch <- prometheus.MustNewConstMetric(PromTablePendingFlushes,
+			prometheus.GaugeValue, float64(stat.PendingFlushes),
+			stat.Table.KeyspaceName, stat.Table.TableName)
+		ch <- prometheus.MustNewConstMetric(PromTableAllMemtablesLiveDataSize,
+			prometheus.GaugeValue, float64(stat.AllMemtablesLiveDataSize),
+			stat.Table.KeyspaceName, stat.Table.TableName)
+		ch <- prometheus.MustNewConstMetric(PromTableAllMemtablesOnHeapSize,
+			prometheus.GaugeValue, float64(stat.AllMemtablesOnHeapSize),
+			stat.Table.KeyspaceName, stat.Table.TableName)
+		ch <- prometheus.MustNewConstMetric(PromTableAllMemtablesOffHeapSize,
+			prometheus.GaugeValue, float64(stat.AllMemtablesOffHeapSize),
+			stat.Table.KeyspaceName, stat.Table.TableName)
+
+		ch <- prometheus.MustNewConstMetric(PromTableBloomFilterOffHeapMemoryUsed,
+			prometheus.GaugeValue, float64(stat.BloomFilterOffHeapMemoryUsed),
+			stat.Table.KeyspaceName, stat.Table.TableName)
+		ch <- prometheus.MustNewConstMetric(PromTableIndexSummaryOffHeapMemoryUsed,
+			prometheus.GaugeValue, float64(stat.IndexSummaryOffHeapMemoryUsed),
+			stat.Table.KeyspaceName, stat.Table.TableName)
+		ch <- prometheus.MustNewConstMetric(PromTableCompressionMetadataOffHeapMemoryUsed,
+			prometheus.GaugeValue, float64(stat.CompressionMetadataOffHeapMemoryUsed),
+			stat.Table.KeyspaceName, stat.Table.TableName)
+		ch <- prometheus.MustNewConstMetric(PromTableBloomFilterDiskSpaceUsed,
+			prometheus.GaugeValue, float64(stat.BloomFilterDiskSpaceUsed),
+			stat.Table.KeyspaceName, stat.Table.TableName)
+
+		ch <- prometheus.MustNewConstHistogram(PromTablePartitionSize,
+			stat.PartitionSizes.Count(),
+			stat.PartitionSizes.Sum(),
+			stat.PartitionSizes.CumulativeCounts(partitionSizeBuckets),
+			stat.Table.KeyspaceName, stat.Table.TableName)
+		ch <- prometheus.MustNewConstHistogram(PromTableCellCount,
+			stat.CellCounts.Count(),
+			stat.CellCounts.Sum(),
+			stat.CellCounts.CumulativeCounts(cellCountBuckets),
+			stat.Table.KeyspaceName, stat.Table.TableName)
+
+		for level, count := range stat.SSTablesPerLevel {
+			ch <- prometheus.MustNewConstMetric(PromTableSSTablesPerLevel,
+				prometheus.GaugeValue, float64(count),
+				stat.Table.KeyspaceName, stat.Table.TableName, strconv.Itoa(level))
+		}
+		for _, window := range stat.SSTablesPerWindow {
+			ch <- prometheus.MustNewConstMetric(PromTableSSTablesPerWindow,
+				prometheus.GaugeValue, float64(window.SSTables),
+				stat.Table.KeyspaceName, stat.Table.TableName,
+				strconv.Itoa(window.Window))
+		}
+
+		// Table configuration is read when the table list is refreshed so
+		// the info labels are left empty if we weren't able to read it and
+		// the rest is only exported if we were
+		config, ok := metrics.TableConfigs[stat.Table]
+		ch <- prometheus.MustNewConstMetric(PromTableInfo,
+			prometheus.GaugeValue, 1,
+			stat.Table.KeyspaceName, stat.Table.TableName,
+			config.CompactionStrategy, config.Compression,
+			strconv.FormatBool(metrics.Views[stat.Table]))
+		if ok {
+			ch <- prometheus.MustNewConstMetric(PromTableCompressionChunkLength,
+				prometheus.GaugeValue, float64(config.CompressionChunkLength),
+				stat.Table.KeyspaceName, stat.Table.TableName)
+			ch <- prometheus.MustNewConstMetric(PromTableMinCompactionThreshold,
+				prometheus.GaugeValue, float64(config.MinCompactionThreshold),
+				stat.Table.KeyspaceName, stat.Table.TableName)
+			ch <- prometheus.MustNewConstMetric(PromTableMaxCompactionThreshold,
+				prometheus.GaugeValue, float64(config.MaxCompactionThreshold),
+				stat.Table.KeyspaceName, stat.Table.TableName)
+			ch <- prometheus.MustNewConstMetric(PromTableDroppableTombstoneRatio,
+				prometheus.GaugeValue, float64(config.DroppableTombstoneRatio),
+				stat.Table.KeyspaceName, stat.Table.TableName)
+		}
+	}
+}
+
+func addKeyspaceStats(metrics ScrapedMetrics, ch chan<- prometheus.Metric) {
+	// KeyspaceStats
+	for _, stat := range metrics.KeyspaceStats {
+		ch <- prometheus.MustNewConstSummary(PromKeyspaceRead,
+			uint64(stat.ReadLatency.Count),
+			float64(stat.ReadLatency.Count)*stat.ReadLatency.Mean.Seconds(),
+			map[float64]float64{
+				75.0: stat.ReadLatency.Percentile75.Seconds(),
+				95.0: stat.ReadLatency.Percentile95.Seconds(),
+				99.0: stat.ReadLatency.Percentile99.Seconds(),
+				99.9: stat.ReadLatency.Percentile999.Seconds(),
+			}, stat.KeyspaceName)
+		ch <- prometheus.MustNewConstSummary(PromKeyspaceWrite,
+			uint64(stat.WriteLatency.Count),
+			float64(stat.WriteLatency.Count)*stat.WriteLatency.Mean.Seconds(),
+			map[float64]float64{
+				75.0: stat.WriteLatency.Percentile75.Seconds(),
+				95.0: stat.WriteLatency.Percentile95.Seconds(),
+				99.0: stat.WriteLatency.Percentile99.Seconds(),
+				99.9: stat.WriteLatency.Percentile999.Seconds(),
+			}, stat.KeyspaceName)
+		ch <- prometheus.MustNewConstSummary(PromKeyspaceRangeScan,
+			uint64(stat.RangeLatency.Count),
+			float64(stat.RangeLatency.Count)*stat.RangeLatency.Mean.Seconds(),
+			map[float64]float64{
+				75.0: stat.RangeLatency.Percentile75.Seconds(),
+				95.0: stat.RangeLatency.Percentile95.Seconds(),
+				99.0: stat.RangeLatency.Percentile99.Seconds(),
+				99.9: stat.RangeLatency.Percentile999.Seconds(),
+			}, stat.KeyspaceName)
+
+		ch <- prometheus.MustNewConstMetric(PromKeyspaceLiveDiskSpaceUsed,
+			prometheus.GaugeValue, float64(stat.LiveDiskSpaceUsed), stat.KeyspaceName)
+		ch <- prometheus.MustNewConstMetric(PromKeyspaceTotalDiskSpaceUsed,
+			prometheus.GaugeValue, float64(stat.TotalDiskSpaceUsed), stat.KeyspaceName)
+		ch <- prometheus.MustNewConstMetric(PromKeyspacePendingCompactions,
+			prometheus.GaugeValue, float64(stat.PendingCompactions), stat.KeyspaceName)
+		ch <- prometheus.MustNewConstMetric(PromKeyspacePendingFlushes,
+			prometheus.GaugeValue, float64(stat.PendingFlushes), stat.KeyspaceName)
+	}
+}
+
+func addIndexStats(metrics ScrapedMetrics, ch chan<- prometheus.Metric) {
+	// IndexStats
+	for _, stat := range metrics.IndexStats {
+		labels := []string{stat.Index.Table.KeyspaceName, stat.Index.Table.TableName, stat.Index.IndexName}
+
+		ch <- prometheus.MustNewConstMetric(PromIndexInfo,
+			prometheus.GaugeValue, 1, append(labels, stat.Index.Type)...)
+
+		if stat.Built != nil {
+			built := 0.0
+			if *stat.Built {
+				built = 1.0
+			}
+			ch <- prometheus.MustNewConstMetric(PromIndexBuilt,
+				prometheus.GaugeValue, built, labels...)
+		}
+		ch <- prometheus.MustNewConstMetric(PromIndexLiveDiskSpaceUsed,
+			prometheus.GaugeValue, float64(stat.LiveDiskSpaceUsed), labels...)
+		ch <- prometheus.MustNewConstMetric(PromIndexMemtableSize,
+			prometheus.GaugeValue, float64(stat.MemtableSize), labels...)
+		ch <- prometheus.MustNewConstSummary(PromIndexQueryLatency,
+			uint64(stat.QueryLatency.Count),
+			float64(stat.QueryLatency.Count)*stat.QueryLatency.Mean.Seconds(),
+			map[float64]float64{
+				75.0: stat.QueryLatency.Percentile75.Seconds(),
+				95.0: stat.QueryLatency.Percentile95.Seconds(),
+				99.0: stat.QueryLatency.Percentile99.Seconds(),
+				99.9: stat.QueryLatency.Percentile999.Seconds(),
+			}, labels...)
+
+		switch stat.Index.Type {
+		case jolokia.IndexTypeSAI:
+			ch <- prometheus.MustNewConstMetric(PromIndexSSTableCells,
+				prometheus.GaugeValue, float64(stat.SSTableCells), labels...)
+			ch <- prometheus.MustNewConstMetric(PromIndexMemtableIndexWrites,
+				prometheus.GaugeValue, float64(stat.MemtableIndexWrites), labels...)
+			ch <- prometheus.MustNewConstSummary(PromIndexMemtableIndexWriteLatency,
+				uint64(stat.MemtableIndexWriteLatency.Count),
+				float64(stat.MemtableIndexWriteLatency.Count)*stat.MemtableIndexWriteLatency.Mean.Seconds(),
+				map[float64]float64{
+					75.0: stat.MemtableIndexWriteLatency.Percentile75.Seconds(),
+					95.0: stat.MemtableIndexWriteLatency.Percentile95.Seconds(),
+					99.0: stat.MemtableIndexWriteLatency.Percentile99.Seconds(),
+					99.9: stat.MemtableIndexWriteLatency.Percentile999.Seconds(),
+				}, labels...)
+			ch <- prometheus.MustNewConstMetric(PromIndexMemtableIndexFlushes,
+				prometheus.CounterValue, float64(stat.MemtableIndexFlushes), labels...)
+			ch <- prometheus.MustNewConstMetric(PromIndexCompactions,
+				prometheus.CounterValue, float64(stat.Compactions), labels...)
+			ch <- prometheus.MustNewConstSummary(PromIndexSegmentsPerCompaction,
+				uint64(stat.SegmentsPerCompaction.Count),
+				float64(stat.SegmentsPerCompaction.Count)*float64(stat.SegmentsPerCompaction.Mean),
+				map[float64]float64{
+					75.0: float64(stat.SegmentsPerCompaction.Percentile75),
+					95.0: float64(stat.SegmentsPerCompaction.Percentile95),
+					99.0: float64(stat.SegmentsPerCompaction.Percentile99),
+					99.9: float64(stat.SegmentsPerCompaction.Percentile999),
+				}, labels...)
+		default:
+			ch <- prometheus.MustNewConstMetric(PromIndexTotalDiskSpaceUsed,
+				prometheus.GaugeValue, float64(stat.TotalDiskSpaceUsed), labels...)
+			ch <- prometheus.MustNewConstMetric(PromIndexLiveSSTables,
+				prometheus.GaugeValue, float64(stat.LiveSSTables), labels...)
+		}
+	}
+}
+
+func addMemtablePoolStats(metrics ScrapedMetrics, ch chan<- prometheus.Metric) {
+	if metrics.MemtablePoolStats == nil {
+		return
 	}
+
+	// MemtablePoolStats
+	ch <- prometheus.MustNewConstMetric(PromMemtablePoolBlockedOnAllocation,
+		prometheus.CounterValue, float64(metrics.MemtablePoolStats.BlockedOnAllocation))
+
+	waiting := metrics.MemtablePoolStats.WaitingOnFreeMemtableSpace
+	ch <- prometheus.MustNewConstSummary(PromMemtablePoolWaitingOnFreeSpace,
+		uint64(waiting.Count),
+		float64(waiting.Count)*waiting.Mean.Seconds(),
+		map[float64]float64{
+			75.0: waiting.Percentile75.Seconds(),
+			95.0: waiting.Percentile95.Seconds(),
+			99.0: waiting.Percentile99.Seconds(),
+			99.9: waiting.Percentile999.Seconds(),
+		})
 }
 
 func addCQLStats(metrics ScrapedMetrics, ch chan<- prometheus.Metric) {
@@ -285,6 +800,46 @@ func addCQLStats(metrics ScrapedMetrics, ch chan<- prometheus.Metric) {
 		prometheus.GaugeValue, float64(metrics.CQLStats.PreparedStatementsRatio))
 }
 
+func addBatchStats(metrics ScrapedMetrics, ch chan<- prometheus.Metric) {
+	if metrics.BatchStats == nil {
+		return
+	}
+
+	// BatchStats
+	logged := metrics.BatchStats.PartitionsPerLoggedBatch
+	ch <- prometheus.MustNewConstSummary(PromBatchPartitionsPerLoggedBatch,
+		uint64(logged.Count),
+		float64(logged.Count)*float64(logged.Mean),
+		map[float64]float64{
+			75.0: float64(logged.Percentile75),
+			95.0: float64(logged.Percentile95),
+			99.0: float64(logged.Percentile99),
+			99.9: float64(logged.Percentile999),
+		})
+
+	unlogged := metrics.BatchStats.PartitionsPerUnloggedBatch
+	ch <- prometheus.MustNewConstSummary(PromBatchPartitionsPerUnloggedBatch,
+		uint64(unlogged.Count),
+		float64(unlogged.Count)*float64(unlogged.Mean),
+		map[float64]float64{
+			75.0: float64(unlogged.Percentile75),
+			95.0: float64(unlogged.Percentile95),
+			99.0: float64(unlogged.Percentile99),
+			99.9: float64(unlogged.Percentile999),
+		})
+
+	counter := metrics.BatchStats.PartitionsPerCounterBatch
+	ch <- prometheus.MustNewConstSummary(PromBatchPartitionsPerCounterBatch,
+		uint64(counter.Count),
+		float64(counter.Count)*float64(counter.Mean),
+		map[float64]float64{
+			75.0: float64(counter.Percentile75),
+			95.0: float64(counter.Percentile95),
+			99.0: float64(counter.Percentile99),
+			99.9: float64(counter.Percentile999),
+		})
+}
+
 func addThreadPoolStats(metrics ScrapedMetrics, ch chan<- prometheus.Metric) {
 	if metrics.ThreadPoolStats == nil {
 		return
@@ -321,6 +876,46 @@ func addCompactionStats(metrics ScrapedMetrics, ch chan<- prometheus.Metric) {
 		prometheus.CounterValue, float64(metrics.CompactionStats.CompletedTasks))
 }
 
+func addActiveCompactions(metrics ScrapedMetrics, ch chan<- prometheus.Metric) {
+	if metrics.ActiveCompactions == nil {
+		return
+	}
+
+	// ActiveCompactions
+	running := 0
+	for _, compaction := range metrics.ActiveCompactions {
+		running += int(compaction.Running)
+
+		ch <- prometheus.MustNewConstMetric(PromActiveCompactionRunning,
+			prometheus.GaugeValue, float64(compaction.Running),
+			compaction.Table.KeyspaceName, compaction.Table.TableName, compaction.TaskType, compaction.Unit)
+		ch <- prometheus.MustNewConstMetric(PromActiveCompactionCompleted,
+			prometheus.GaugeValue, float64(compaction.Completed),
+			compaction.Table.KeyspaceName, compaction.Table.TableName, compaction.TaskType, compaction.Unit)
+		ch <- prometheus.MustNewConstMetric(PromActiveCompactionTotal,
+			prometheus.GaugeValue, float64(compaction.Total),
+			compaction.Table.KeyspaceName, compaction.Table.TableName, compaction.TaskType, compaction.Unit)
+	}
+
+	ch <- prometheus.MustNewConstMetric(PromCompactionsRunning,
+		prometheus.GaugeValue, float64(running))
+}
+
+func addCompactionHistory(metrics ScrapedMetrics, ch chan<- prometheus.Metric) {
+	// CompactionHistory
+	for _, stat := range metrics.CompactionHistory {
+		ch <- prometheus.MustNewConstMetric(PromCompactionHistoryCompactions,
+			prometheus.CounterValue, float64(stat.Compactions),
+			stat.Table.KeyspaceName, stat.Table.TableName)
+		ch <- prometheus.MustNewConstMetric(PromCompactionHistoryBytesIn,
+			prometheus.CounterValue, float64(stat.BytesIn),
+			stat.Table.KeyspaceName, stat.Table.TableName)
+		ch <- prometheus.MustNewConstMetric(PromCompactionHistoryBytesOut,
+			prometheus.CounterValue, float64(stat.BytesOut),
+			stat.Table.KeyspaceName, stat.Table.TableName)
+	}
+}
+
 func addClientRequestStats(metrics ScrapedMetrics, ch chan<- prometheus.Metric) {
 	// ClientRequestStats
 	for _, stat := range metrics.ClientRequestStats {
@@ -332,13 +927,38 @@ func addClientRequestStats(metrics ScrapedMetrics, ch chan<- prometheus.Metric)
 				95.0: stat.RequestLatency.Percentile95.Seconds(),
 				99.0: stat.RequestLatency.Percentile99.Seconds(),
 				99.9: stat.RequestLatency.Percentile999.Seconds(),
-			}, stat.RequestType)
+			}, stat.RequestType, stat.ConsistencyLevel)
 		ch <- prometheus.MustNewConstMetric(PromClientRequestTimeouts,
-			prometheus.CounterValue, float64(stat.Timeouts), stat.RequestType)
+			prometheus.CounterValue, float64(stat.Timeouts), stat.RequestType, stat.ConsistencyLevel)
 		ch <- prometheus.MustNewConstMetric(PromClientRequestFailures,
-			prometheus.CounterValue, float64(stat.Failures), stat.RequestType)
+			prometheus.CounterValue, float64(stat.Failures), stat.RequestType, stat.ConsistencyLevel)
 		ch <- prometheus.MustNewConstMetric(PromClientRequestUnavailable,
-			prometheus.CounterValue, float64(stat.Unavailables), stat.RequestType)
+			prometheus.CounterValue, float64(stat.Unavailables), stat.RequestType, stat.ConsistencyLevel)
+	}
+}
+
+func addCASRequestStats(metrics ScrapedMetrics, ch chan<- prometheus.Metric) {
+	// CASRequestStats
+	for _, stat := range metrics.CASRequestStats {
+		ch <- prometheus.MustNewConstSummary(PromCASRequestContention,
+			uint64(stat.Contention.Count),
+			float64(stat.Contention.Count)*float64(stat.Contention.Mean),
+			map[float64]float64{
+				75.0: float64(stat.Contention.Percentile75),
+				95.0: float64(stat.Contention.Percentile95),
+				99.0: float64(stat.Contention.Percentile99),
+				99.9: float64(stat.Contention.Percentile999),
+			}, stat.RequestType)
+		ch <- prometheus.MustNewConstMetric(PromCASRequestUnfinishedCommit,
+			prometheus.CounterValue, float64(stat.UnfinishedCommit), stat.RequestType)
+		ch <- prometheus.MustNewConstMetric(PromCASRequestConditionNotMet,
+			prometheus.CounterValue, float64(stat.ConditionNotMet), stat.RequestType)
+		ch <- prometheus.MustNewConstMetric(PromCASRequestUnknownResult,
+			prometheus.CounterValue, float64(stat.UnknownResult), stat.RequestType)
+		for _, meter := range stat.PaxosRepairs {
+			ch <- prometheus.MustNewConstMetric(PromCASRequestPaxosRepairs,
+				prometheus.CounterValue, float64(meter.Count), stat.RequestType, meter.Name)
+		}
 	}
 }
 
@@ -351,6 +971,67 @@ func addConnectedClientStats(metrics ScrapedMetrics, ch chan<- prometheus.Metric
 		prometheus.GaugeValue, float64(*metrics.ConnectedClients))
 }
 
+func addClientConnectionStats(metrics ScrapedMetrics, ch chan<- prometheus.Metric) {
+	if metrics.ClientConnectionStats == nil {
+		return
+	}
+
+	// ClientConnectionStats
+	for _, group := range metrics.ClientConnectionStats.ByUser {
+		ch <- prometheus.MustNewConstMetric(PromClientConnectionsByUser,
+			prometheus.GaugeValue, float64(group.Connections), group.Name)
+	}
+	for _, group := range metrics.ClientConnectionStats.ByDriver {
+		ch <- prometheus.MustNewConstMetric(PromClientConnectionsByDriver,
+			prometheus.GaugeValue, float64(group.Connections), group.Name, group.Version)
+	}
+	for _, group := range metrics.ClientConnectionStats.ByProtocolVersion {
+		ch <- prometheus.MustNewConstMetric(PromClientConnectionsByProtocolVersion,
+			prometheus.GaugeValue, float64(group.Connections), group.Name)
+	}
+}
+
+func addNativeTransportStats(metrics ScrapedMetrics, ch chan<- prometheus.Metric) {
+	if metrics.NativeTransportStats == nil {
+		return
+	}
+
+	// NativeTransportStats
+	ch <- prometheus.MustNewConstMetric(PromNativeTransportPausedConnections,
+		prometheus.GaugeValue, float64(metrics.NativeTransportStats.PausedConnections))
+	ch <- prometheus.MustNewConstMetric(PromNativeTransportRequestsDiscarded,
+		prometheus.CounterValue, float64(metrics.NativeTransportStats.RequestsDiscarded))
+	ch <- prometheus.MustNewConstMetric(PromNativeTransportQueuedRequests,
+		prometheus.GaugeValue, float64(metrics.NativeTransportStats.QueuedRequests))
+	ch <- prometheus.MustNewConstMetric(PromNativeTransportRequestsInFlight,
+		prometheus.GaugeValue, float64(metrics.NativeTransportStats.RequestsSizeInFlight))
+
+	requestSize := metrics.NativeTransportStats.RequestSize
+	ch <- prometheus.MustNewConstSummary(PromNativeTransportRequestSize,
+		uint64(requestSize.Count),
+		float64(requestSize.Count)*float64(requestSize.Mean),
+		map[float64]float64{
+			75.0: float64(requestSize.Percentile75),
+			95.0: float64(requestSize.Percentile95),
+			99.0: float64(requestSize.Percentile99),
+			99.9: float64(requestSize.Percentile999),
+		})
+}
+
+func addAuthCacheStats(metrics ScrapedMetrics, ch chan<- prometheus.Metric) {
+	// AuthCacheStats
+	for _, cache := range metrics.AuthCacheStats {
+		ch <- prometheus.MustNewConstMetric(PromAuthCacheHits,
+			prometheus.CounterValue, float64(cache.Hits), cache.CacheName)
+		ch <- prometheus.MustNewConstMetric(PromAuthCacheMisses,
+			prometheus.CounterValue, float64(cache.Misses), cache.CacheName)
+		ch <- prometheus.MustNewConstMetric(PromAuthCacheSize,
+			prometheus.GaugeValue, float64(cache.Size), cache.CacheName)
+		ch <- prometheus.MustNewConstMetric(PromAuthCacheCapacity,
+			prometheus.GaugeValue, float64(cache.Capacity), cache.CacheName)
+	}
+}
+
 func addMemoryStats(metrics ScrapedMetrics, ch chan<- prometheus.Metric) {
 	if metrics.MemoryStats == nil {
 		return
@@ -359,8 +1040,129 @@ func addMemoryStats(metrics ScrapedMetrics, ch chan<- prometheus.Metric) {
 	// MemoryStats
 	ch <- prometheus.MustNewConstMetric(PromMemoryStatsHeapUsed,
 		prometheus.GaugeValue, float64(metrics.MemoryStats.HeapUsed))
+	ch <- prometheus.MustNewConstMetric(PromMemoryStatsHeapCommitted,
+		prometheus.GaugeValue, float64(metrics.MemoryStats.HeapCommitted))
+	// The JVM reports -1 if the heap doesn't have a maximum size
+	if metrics.MemoryStats.HeapMax >= 0 {
+		ch <- prometheus.MustNewConstMetric(PromMemoryStatsHeapMax,
+			prometheus.GaugeValue, float64(metrics.MemoryStats.HeapMax))
+	}
 	ch <- prometheus.MustNewConstMetric(PromMemoryStatsNonHeapUsed,
 		prometheus.GaugeValue, float64(metrics.MemoryStats.NonHeapUsed))
+	ch <- prometheus.MustNewConstMetric(PromMemoryStatsNonHeapCommitted,
+		prometheus.GaugeValue, float64(metrics.MemoryStats.NonHeapCommitted))
+}
+
+func addJVMStats(metrics ScrapedMetrics, ch chan<- prometheus.Metric) {
+	if metrics.JVMStats == nil {
+		return
+	}
+
+	// JVMStats
+	ch <- prometheus.MustNewConstMetric(PromJVMInfo,
+		prometheus.GaugeValue, 1, metrics.JVMStats.Vendor, metrics.JVMStats.Version,
+		metrics.JVMStats.InputArgumentsHash)
+	ch <- prometheus.MustNewConstMetric(PromJVMStartTime,
+		prometheus.GaugeValue, float64(metrics.JVMStats.StartTime.UnixNano())/1e9)
+	ch <- prometheus.MustNewConstMetric(PromJVMUptime,
+		prometheus.GaugeValue, metrics.JVMStats.Uptime.Seconds())
+
+	// The rest of the JVM stats are best effort so we only export the ones
+	// which could be read
+	if metrics.JVMStats.HasThreads {
+		ch <- prometheus.MustNewConstMetric(PromJVMThreads,
+			prometheus.GaugeValue, float64(metrics.JVMStats.Threads))
+		ch <- prometheus.MustNewConstMetric(PromJVMDaemonThreads,
+			prometheus.GaugeValue, float64(metrics.JVMStats.DaemonThreads))
+		ch <- prometheus.MustNewConstMetric(PromJVMPeakThreads,
+			prometheus.GaugeValue, float64(metrics.JVMStats.PeakThreads))
+		ch <- prometheus.MustNewConstMetric(PromJVMThreadsStarted,
+			prometheus.CounterValue, float64(metrics.JVMStats.ThreadsStarted))
+	}
+	if metrics.JVMStats.HasClasses {
+		ch <- prometheus.MustNewConstMetric(PromJVMLoadedClasses,
+			prometheus.GaugeValue, float64(metrics.JVMStats.LoadedClasses))
+		ch <- prometheus.MustNewConstMetric(PromJVMClassesLoaded,
+			prometheus.CounterValue, float64(metrics.JVMStats.ClassesLoaded))
+		ch <- prometheus.MustNewConstMetric(PromJVMClassesUnloaded,
+			prometheus.CounterValue, float64(metrics.JVMStats.ClassesUnloaded))
+	}
+	if metrics.JVMStats.HasCompilationTime {
+		ch <- prometheus.MustNewConstMetric(PromJVMCompilationTime,
+			prometheus.CounterValue, metrics.JVMStats.CompilationTime.Seconds())
+	}
+}
+
+func addMemoryPoolStats(metrics ScrapedMetrics, ch chan<- prometheus.Metric) {
+	// MemoryPoolStats
+	for _, stat := range metrics.MemoryPoolStats {
+		ch <- prometheus.MustNewConstMetric(PromMemoryPoolUsed,
+			prometheus.GaugeValue, float64(stat.Used), stat.Name, stat.Type)
+		ch <- prometheus.MustNewConstMetric(PromMemoryPoolCommitted,
+			prometheus.GaugeValue, float64(stat.Committed), stat.Name, stat.Type)
+
+		// Not every pool has a maximum size or is garbage collected
+		if stat.Max >= 0 {
+			ch <- prometheus.MustNewConstMetric(PromMemoryPoolMax,
+				prometheus.GaugeValue, float64(stat.Max), stat.Name, stat.Type)
+		}
+		if stat.PostGCUsed >= 0 {
+			ch <- prometheus.MustNewConstMetric(PromMemoryPoolPostGCUsed,
+				prometheus.GaugeValue, float64(stat.PostGCUsed), stat.Name, stat.Type)
+		}
+	}
+}
+
+func addBufferPoolStats(metrics ScrapedMetrics, ch chan<- prometheus.Metric) {
+	// BufferPoolStats
+	for _, stat := range metrics.BufferPoolStats {
+		ch <- prometheus.MustNewConstMetric(PromBufferPoolBuffers,
+			prometheus.GaugeValue, float64(stat.Buffers), stat.Name)
+		ch <- prometheus.MustNewConstMetric(PromBufferPoolUsed,
+			prometheus.GaugeValue, float64(stat.Used), stat.Name)
+		ch <- prometheus.MustNewConstMetric(PromBufferPoolCapacity,
+			prometheus.GaugeValue, float64(stat.TotalCapacity), stat.Name)
+	}
+}
+
+func addOperatingSystemStats(metrics ScrapedMetrics, ch chan<- prometheus.Metric) {
+	if metrics.OperatingSystemStats == nil {
+		return
+	}
+
+	// OperatingSystemStats
+	stats := metrics.OperatingSystemStats
+	ch <- prometheus.MustNewConstMetric(PromProcessOpenFDs,
+		prometheus.GaugeValue, float64(stats.OpenFileDescriptors))
+	ch <- prometheus.MustNewConstMetric(PromProcessMaxFDs,
+		prometheus.GaugeValue, float64(stats.MaxFileDescriptors))
+	ch <- prometheus.MustNewConstMetric(PromProcessCPUTime,
+		prometheus.CounterValue, stats.ProcessCPUTime.Seconds())
+	ch <- prometheus.MustNewConstMetric(PromOSAvailableProcessors,
+		prometheus.GaugeValue, float64(stats.AvailableProcessors))
+	ch <- prometheus.MustNewConstMetric(PromOSMemoryFree,
+		prometheus.GaugeValue, float64(stats.FreePhysicalMemory))
+	ch <- prometheus.MustNewConstMetric(PromOSMemoryTotal,
+		prometheus.GaugeValue, float64(stats.TotalPhysicalMemory))
+	ch <- prometheus.MustNewConstMetric(PromOSSwapFree,
+		prometheus.GaugeValue, float64(stats.FreeSwapSpace))
+	ch <- prometheus.MustNewConstMetric(PromOSSwapTotal,
+		prometheus.GaugeValue, float64(stats.TotalSwapSpace))
+
+	// The loads are negative if they aren't available (such as just after
+	// the process has started or on platforms which don't support them)
+	if stats.ProcessCPULoad >= 0 {
+		ch <- prometheus.MustNewConstMetric(PromProcessCPULoad,
+			prometheus.GaugeValue, float64(stats.ProcessCPULoad))
+	}
+	if stats.SystemCPULoad >= 0 {
+		ch <- prometheus.MustNewConstMetric(PromOSCPULoad,
+			prometheus.GaugeValue, float64(stats.SystemCPULoad))
+	}
+	if stats.SystemLoadAverage >= 0 {
+		ch <- prometheus.MustNewConstMetric(PromOSLoadAverage,
+			prometheus.GaugeValue, float64(stats.SystemLoadAverage))
+	}
 }
 
 func addGCStats(metrics ScrapedMetrics, ch chan<- prometheus.Metric) {
@@ -440,6 +1242,28 @@ func addStorageStats(metrics ScrapedMetrics, ch chan<- prometheus.Metric) {
 	}
 }
 
+func addSnapshotStats(metrics ScrapedMetrics, ch chan<- prometheus.Metric) {
+	if metrics.SnapshotStats == nil {
+		return
+	}
+
+	// SnapshotStats
+	if metrics.SnapshotStats.TrueSize != nil {
+		ch <- prometheus.MustNewConstMetric(PromSnapshotsTrueSize,
+			prometheus.GaugeValue, float64(*metrics.SnapshotStats.TrueSize))
+	}
+	for _, stat := range metrics.SnapshotStats.Tables {
+		ch <- prometheus.MustNewConstMetric(PromTableSnapshots,
+			prometheus.GaugeValue, float64(stat.Snapshots),
+			stat.Table.KeyspaceName, stat.Table.TableName)
+	}
+	for _, stat := range metrics.SnapshotStats.TableSizes {
+		ch <- prometheus.MustNewConstMetric(PromTableSnapshotsSize,
+			prometheus.GaugeValue, float64(stat.Size),
+			stat.Table.KeyspaceName, stat.Table.TableName)
+	}
+}
+
 func addStorageCoreStats(metrics ScrapedMetrics, ch chan<- prometheus.Metric) {
 	if metrics.StorageCoreStats == nil {
 		return
@@ -452,3 +1276,117 @@ func addStorageCoreStats(metrics ScrapedMetrics, ch chan<- prometheus.Metric) {
 	ch <- prometheus.MustNewConstMetric(PromStorageInternalExceptions,
 		prometheus.CounterValue, float64(metrics.StorageCoreStats.InternalExceptions))
 }
+
+func addMessagingStats(metrics ScrapedMetrics, ch chan<- prometheus.Metric) {
+	if metrics.MessagingStats == nil {
+		return
+	}
+
+	// MessagingStats
+	crossNode := metrics.MessagingStats.CrossNodeLatency
+	ch <- prometheus.MustNewConstSummary(PromMessagingCrossNodeLatency,
+		uint64(crossNode.Count),
+		float64(crossNode.Count)*crossNode.Mean.Seconds(),
+		map[float64]float64{
+			75.0: crossNode.Percentile75.Seconds(),
+			95.0: crossNode.Percentile95.Seconds(),
+			99.0: crossNode.Percentile99.Seconds(),
+			99.9: crossNode.Percentile999.Seconds(),
+		})
+
+	for _, stat := range metrics.MessagingStats.DatacenterLatency {
+		ch <- prometheus.MustNewConstSummary(PromMessagingDatacenterLatency,
+			uint64(stat.Latency.Count),
+			float64(stat.Latency.Count)*stat.Latency.Mean.Seconds(),
+			map[float64]float64{
+				75.0: stat.Latency.Percentile75.Seconds(),
+				95.0: stat.Latency.Percentile95.Seconds(),
+				99.0: stat.Latency.Percentile99.Seconds(),
+				99.9: stat.Latency.Percentile999.Seconds(),
+			}, stat.Datacenter)
+	}
+}
+
+func addHintsStats(metrics ScrapedMetrics, ch chan<- prometheus.Metric) {
+	if metrics.HintsStats == nil {
+		return
+	}
+
+	// HintsStats
+	ch <- prometheus.MustNewConstMetric(PromHintsSucceeded,
+		prometheus.CounterValue, float64(metrics.HintsStats.Succeeded))
+	ch <- prometheus.MustNewConstMetric(PromHintsFailed,
+		prometheus.CounterValue, float64(metrics.HintsStats.Failed))
+	ch <- prometheus.MustNewConstMetric(PromHintsTimedOut,
+		prometheus.CounterValue, float64(metrics.HintsStats.TimedOut))
+
+	// Hint delays are recorded in milliseconds, we convert them to seconds
+	for _, stat := range metrics.HintsStats.Delays {
+		ch <- prometheus.MustNewConstSummary(PromHintsDelay,
+			uint64(stat.Delay.Count),
+			float64(stat.Delay.Count)*float64(stat.Delay.Mean)/1000.0,
+			map[float64]float64{
+				75.0: float64(stat.Delay.Percentile75) / 1000.0,
+				95.0: float64(stat.Delay.Percentile95) / 1000.0,
+				99.0: float64(stat.Delay.Percentile99) / 1000.0,
+				99.9: float64(stat.Delay.Percentile999) / 1000.0,
+			}, stat.Endpoint)
+	}
+}
+
+func addRepairStats(metrics ScrapedMetrics, ch chan<- prometheus.Metric) {
+	if metrics.RepairStats == nil {
+		return
+	}
+
+	// RepairStats
+	for _, stat := range metrics.RepairStats.Sessions {
+		ch <- prometheus.MustNewConstMetric(PromRepairSessions,
+			prometheus.GaugeValue, float64(stat.Sessions), stat.State)
+	}
+	ch <- prometheus.MustNewConstMetric(PromRepairPreviewFailures,
+		prometheus.CounterValue, float64(metrics.RepairStats.PreviewFailures))
+}
+
+func addReadRepairStats(metrics ScrapedMetrics, ch chan<- prometheus.Metric) {
+	if metrics.ReadRepairStats == nil {
+		return
+	}
+
+	// ReadRepairStats
+	ch <- prometheus.MustNewConstMetric(PromReadRepairRepairedBlocking,
+		prometheus.CounterValue, float64(metrics.ReadRepairStats.RepairedBlocking))
+	ch <- prometheus.MustNewConstMetric(PromReadRepairRepairedBackground,
+		prometheus.CounterValue, float64(metrics.ReadRepairStats.RepairedBackground))
+	ch <- prometheus.MustNewConstMetric(PromReadRepairAttempted,
+		prometheus.CounterValue, float64(metrics.ReadRepairStats.Attempted))
+	ch <- prometheus.MustNewConstMetric(PromReadRepairSpeculatedRead,
+		prometheus.CounterValue, float64(metrics.ReadRepairStats.SpeculatedRead))
+	ch <- prometheus.MustNewConstMetric(PromReadRepairSpeculatedWrite,
+		prometheus.CounterValue, float64(metrics.ReadRepairStats.SpeculatedWrite))
+	ch <- prometheus.MustNewConstMetric(PromReadRepairReconcileRead,
+		prometheus.CounterValue, float64(metrics.ReadRepairStats.ReconcileRead))
+}
+
+func addViewWriteStats(metrics ScrapedMetrics, ch chan<- prometheus.Metric) {
+	if metrics.ViewWriteStats == nil {
+		return
+	}
+
+	// ViewWriteStats
+	ch <- prometheus.MustNewConstMetric(PromViewWriteReplicasAttempted,
+		prometheus.CounterValue, float64(metrics.ViewWriteStats.ReplicasAttempted))
+	ch <- prometheus.MustNewConstMetric(PromViewWriteReplicasSuccess,
+		prometheus.CounterValue, float64(metrics.ViewWriteStats.ReplicasSuccess))
+	ch <- prometheus.MustNewConstMetric(PromViewWritePendingMutations,
+		prometheus.GaugeValue, float64(metrics.ViewWriteStats.PendingMutations))
+	ch <- prometheus.MustNewConstSummary(PromViewWriteLatency,
+		uint64(metrics.ViewWriteStats.WriteLatency.Count),
+		float64(metrics.ViewWriteStats.WriteLatency.Count)*metrics.ViewWriteStats.WriteLatency.Mean.Seconds(),
+		map[float64]float64{
+			75.0: metrics.ViewWriteStats.WriteLatency.Percentile75.Seconds(),
+			95.0: metrics.ViewWriteStats.WriteLatency.Percentile95.Seconds(),
+			99.0: metrics.ViewWriteStats.WriteLatency.Percentile99.Seconds(),
+			99.9: metrics.ViewWriteStats.WriteLatency.Percentile999.Seconds(),
+		})
+}
@@ -67,6 +67,18 @@ var (
 		[]string{"keyspace", "table"}, nil,
 	)
 
+	PromTableViewLockAcquireTime = prometheus.NewDesc(
+		"seastat_table_view_lock_acquire_latency_seconds",
+		"Time taken acquiring the partition lock for materialized view updates",
+		[]string{"keyspace", "table"}, nil,
+	)
+
+	PromTableViewReadTime = prometheus.NewDesc(
+		"seastat_table_view_read_latency_seconds",
+		"Time taken during the local read of a materialized view update",
+		[]string{"keyspace", "table"}, nil,
+	)
+
 	PromTableEstimatedPartitionCount = prometheus.NewDesc(
 		"seastat_table_estimated_partitions",
 		"Number of partitions in this table (estimated)",
@@ -91,6 +103,12 @@ var (
 		[]string{"keyspace", "table"}, nil,
 	)
 
+	PromTableSnapshotsSize = prometheus.NewDesc(
+		"seastat_table_snapshots_size_bytes",
+		"Disk space used by snapshots of this table in bytes",
+		[]string{"keyspace", "table"}, nil,
+	)
+
 	PromTableLiveSSTables = prometheus.NewDesc(
 		"seastat_table_live_sstables",
 		"Number of live SSTables",
@@ -162,6 +180,375 @@ var (
 		"Compression ratio for the table (a ratio of compressed size over uncompressed size)",
 		[]string{"keyspace", "table"}, nil,
 	)
+
+	PromTableReadRepairRequests = prometheus.NewDesc(
+		"seastat_table_read_repair_requests_total",
+		"Total number of read repair requests for the table",
+		[]string{"keyspace", "table"}, nil,
+	)
+
+	PromTableShortReadProtectionRequests = prometheus.NewDesc(
+		"seastat_table_short_read_protection_requests_total",
+		"Total number of short read protection requests for the table",
+		[]string{"keyspace", "table"}, nil,
+	)
+
+	PromTableDroppedMutations = prometheus.NewDesc(
+		"seastat_table_dropped_mutations_total",
+		"Total number of dropped mutations for the table",
+		[]string{"keyspace", "table"}, nil,
+	)
+
+	PromTableRowCacheHits = prometheus.NewDesc(
+		"seastat_table_row_cache_hits_total",
+		"Total number of row cache hits",
+		[]string{"keyspace", "table"}, nil,
+	)
+
+	PromTableRowCacheMisses = prometheus.NewDesc(
+		"seastat_table_row_cache_misses_total",
+		"Total number of row cache misses",
+		[]string{"keyspace", "table"}, nil,
+	)
+
+	PromTableRowCacheHitsOutOfRange = prometheus.NewDesc(
+		"seastat_table_row_cache_hits_out_of_range_total",
+		"Total number of row cache hits which did not satisfy the query filter",
+		[]string{"keyspace", "table"}, nil,
+	)
+
+	PromTableTombstoneWarnings = prometheus.NewDesc(
+		"seastat_table_tombstone_warnings_total",
+		"Total number of queries which exceeded the tombstone warning threshold",
+		[]string{"keyspace", "table"}, nil,
+	)
+
+	PromTableTombstoneFailures = prometheus.NewDesc(
+		"seastat_table_tombstone_failures_total",
+		"Total number of queries which exceeded the tombstone failure threshold",
+		[]string{"keyspace", "table"}, nil,
+	)
+
+	PromTableBytesRepaired = prometheus.NewDesc(
+		"seastat_table_repaired_bytes",
+		"Size of SSTables marked as repaired in bytes",
+		[]string{"keyspace", "table"}, nil,
+	)
+
+	PromTableBytesUnrepaired = prometheus.NewDesc(
+		"seastat_table_unrepaired_bytes",
+		"Size of SSTables not marked as repaired in bytes",
+		[]string{"keyspace", "table"}, nil,
+	)
+
+	PromTableBytesPendingRepair = prometheus.NewDesc(
+		"seastat_table_pending_repair_bytes",
+		"Size of SSTables which are part of an incremental repair which hasn't finished yet in bytes",
+		[]string{"keyspace", "table"}, nil,
+	)
+
+	PromTableRepairedDataInconsistenciesConfirmed = prometheus.NewDesc(
+		"seastat_table_repaired_data_inconsistencies_confirmed_total",
+		"Total number of reads where the repaired data was confirmed to differ between replicas",
+		[]string{"keyspace", "table"}, nil,
+	)
+
+	PromTableRepairedDataInconsistenciesUnconfirmed = prometheus.NewDesc(
+		"seastat_table_repaired_data_inconsistencies_unconfirmed_total",
+		"Total number of reads where the repaired data may differ between replicas",
+		[]string{"keyspace", "table"}, nil,
+	)
+
+	PromTableAnticompactionTime = prometheus.NewDesc(
+		"seastat_table_anticompaction_latency_seconds",
+		"Time spent anticompacting SSTables before an incremental repair",
+		[]string{"keyspace", "table"}, nil,
+	)
+
+	PromTableValidationTime = prometheus.NewDesc(
+		"seastat_table_validation_latency_seconds",
+		"Time spent building merkle trees during repair validation",
+		[]string{"keyspace", "table"}, nil,
+	)
+
+	PromTableRepairSyncTime = prometheus.NewDesc(
+		"seastat_table_repair_sync_latency_seconds",
+		"Time spent streaming data between replicas during repair",
+		[]string{"keyspace", "table"}, nil,
+	)
+
+	PromTableMemtableLiveDataSize = prometheus.NewDesc(
+		"seastat_table_memtable_live_data_size_bytes",
+		"Size of live data in the memtable in bytes",
+		[]string{"keyspace", "table"}, nil,
+	)
+
+	PromTableMemtableOnHeapSize = prometheus.NewDesc(
+		"seastat_table_memtable_on_heap_size_bytes",
+		"On-heap memory used by the memtable in bytes",
+		[]string{"keyspace", "table"}, nil,
+	)
+
+	PromTableMemtableOffHeapSize = prometheus.NewDesc(
+		"seastat_table_memtable_off_heap_size_bytes",
+		"Off-heap memory used by the memtable in bytes",
+		[]string{"keyspace", "table"}, nil,
+	)
+
+	PromTableMemtableColumns = prometheus.NewDesc(
+		"seastat_table_memtable_columns",
+		"Number of columns in the memtable",
+		[]string{"keyspace", "table"}, nil,
+	)
+
+	PromTableMemtableSwitches = prometheus.NewDesc(
+		"seastat_table_memtable_switches_total",
+		"Number of times a flush has resulted in the memtable being switched out",
+		[]string{"keyspace", "table"}, nil,
+	)
+
+	PromTablePendingFlushes = prometheus.NewDesc(
+		"seastat_table_pending_flushes",
+		"Number of flushes pending for the table",
+		[]string{"keyspace", "table"}, nil,
+	)
+
+	PromTableAllMemtablesLiveDataSize = prometheus.NewDesc(
+		"seastat_table_all_memtables_live_data_size_bytes",
+		"Size of live data in all memtables (including secondary indexes) in bytes",
+		[]string{"keyspace", "table"}, nil,
+	)
+
+	PromTableAllMemtablesOnHeapSize = prometheus.NewDesc(
+		"seastat_table_all_memtables_on_heap_size_bytes",
+		"On-heap memory used by all memtables (including secondary indexes) in bytes",
+		[]string{"keyspace", "table"}, nil,
+	)
+
+	PromTableAllMemtablesOffHeapSize = prometheus.NewDesc(
+		"seastat_table_all_memtables_off_heap_size_bytes",
+		"Off-heap memory used by all memtables (including secondary indexes) in bytes",
+		[]string{"keyspace", "table"}, nil,
+	)
+
+	PromTableBloomFilterOffHeapMemoryUsed = prometheus.NewDesc(
+		"seastat_table_bloom_filter_off_heap_memory_used_bytes",
+		"Off-heap memory used by the bloom filter in bytes",
+		[]string{"keyspace", "table"}, nil,
+	)
+
+	PromTableIndexSummaryOffHeapMemoryUsed = prometheus.NewDesc(
+		"seastat_table_index_summary_off_heap_memory_used_bytes",
+		"Off-heap memory used by the index summary in bytes",
+		[]string{"keyspace", "table"}, nil,
+	)
+
+	PromTableCompressionMetadataOffHeapMemoryUsed = prometheus.NewDesc(
+		"seastat_table_compression_metadata_off_heap_memory_used_bytes",
+		"Off-heap memory used by the compression metadata in bytes",
+		[]string{"keyspace", "table"}, nil,
+	)
+
+	PromTableBloomFilterDiskSpaceUsed = prometheus.NewDesc(
+		"seastat_table_bloom_filter_disk_space_used_bytes",
+		"Disk space used by the bloom filter in bytes",
+		[]string{"keyspace", "table"}, nil,
+	)
+
+	PromTablePartitionSize = prometheus.NewDesc(
+		"seastat_table_partition_size_bytes",
+		"Distribution of partition sizes across SSTables in bytes",
+		[]string{"keyspace", "table"}, nil,
+	)
+
+	PromTableCellCount = prometheus.NewDesc(
+		"seastat_table_partition_cells",
+		"Distribution of the number of cells per partition across SSTables",
+		[]string{"keyspace", "table"}, nil,
+	)
+
+	PromTableSSTablesPerLevel = prometheus.NewDesc(
+		"seastat_table_sstables_per_level",
+		"Number of SSTables in each level for tables using LeveledCompactionStrategy",
+		[]string{"keyspace", "table", "level"}, nil,
+	)
+
+	PromTableSSTablesPerWindow = prometheus.NewDesc(
+		"seastat_table_sstables_per_window",
+		"Number of SSTables in each time window (0 being the newest) for tables using TimeWindowCompactionStrategy",
+		[]string{"keyspace", "table", "window"}, nil,
+	)
+
+	PromTableInfo = prometheus.NewDesc(
+		"seastat_table_info",
+		"Information about the configuration of the table, the value is always 1",
+		[]string{"keyspace", "table", "compaction_strategy", "compression", "view"}, nil,
+	)
+
+	PromTableCompressionChunkLength = prometheus.NewDesc(
+		"seastat_table_compression_chunk_length_bytes",
+		"Size of the chunks which SSTables are compressed in",
+		[]string{"keyspace", "table"}, nil,
+	)
+
+	PromTableMinCompactionThreshold = prometheus.NewDesc(
+		"seastat_table_min_compaction_threshold",
+		"Minimum number of SSTables needed to trigger a minor compaction",
+		[]string{"keyspace", "table"}, nil,
+	)
+
+	PromTableMaxCompactionThreshold = prometheus.NewDesc(
+		"seastat_table_max_compaction_threshold",
+		"Maximum number of SSTables compacted in a single minor compaction",
+		[]string{"keyspace", "table"}, nil,
+	)
+
+	PromTableDroppableTombstoneRatio = prometheus.NewDesc(
+		"seastat_table_droppable_tombstone_ratio",
+		"Estimated ratio of droppable tombstones to columns across SSTables",
+		[]string{"keyspace", "table"}, nil,
+	)
+)
+
+// KeyspaceStats
+var (
+	PromKeyspaceRead = prometheus.NewDesc(
+		"seastat_keyspace_read_latency_seconds",
+		"Local read latency across all tables in the keyspace",
+		[]string{"keyspace"}, nil,
+	)
+
+	PromKeyspaceWrite = prometheus.NewDesc(
+		"seastat_keyspace_write_latency_seconds",
+		"Local write latency across all tables in the keyspace",
+		[]string{"keyspace"}, nil,
+	)
+
+	PromKeyspaceRangeScan = prometheus.NewDesc(
+		"seastat_keyspace_range_scan_latency_seconds",
+		"Local range scan latency across all tables in the keyspace",
+		[]string{"keyspace"}, nil,
+	)
+
+	PromKeyspaceLiveDiskSpaceUsed = prometheus.NewDesc(
+		"seastat_keyspace_live_disk_space_used_bytes",
+		"Disk space used by live data across all tables in the keyspace in bytes",
+		[]string{"keyspace"}, nil,
+	)
+
+	PromKeyspaceTotalDiskSpaceUsed = prometheus.NewDesc(
+		"seastat_keyspace_total_disk_space_used_bytes",
+		"Disk space used by all data across all tables in the keyspace in bytes",
+		[]string{"keyspace"}, nil,
+	)
+
+	PromKeyspacePendingCompactions = prometheus.NewDesc(
+		"seastat_keyspace_pending_compactions",
+		"Estimated number of compactions remaining across all tables in the keyspace",
+		[]string{"keyspace"}, nil,
+	)
+
+	PromKeyspacePendingFlushes = prometheus.NewDesc(
+		"seastat_keyspace_pending_flushes",
+		"Number of flushes pending across all tables in the keyspace",
+		[]string{"keyspace"}, nil,
+	)
+)
+
+// IndexStats
+var (
+	PromIndexInfo = prometheus.NewDesc(
+		"seastat_index_info",
+		"Information about the secondary index, the value is always 1",
+		[]string{"keyspace", "table", "index", "type"}, nil,
+	)
+
+	PromIndexBuilt = prometheus.NewDesc(
+		"seastat_index_built",
+		"Whether the secondary index has finished building (1 if built, 0 if not)",
+		[]string{"keyspace", "table", "index"}, nil,
+	)
+
+	PromIndexLiveDiskSpaceUsed = prometheus.NewDesc(
+		"seastat_index_live_disk_space_used_bytes",
+		"Disk space used by the live data of the index in bytes",
+		[]string{"keyspace", "table", "index"}, nil,
+	)
+
+	PromIndexMemtableSize = prometheus.NewDesc(
+		"seastat_index_memtable_size_bytes",
+		"Memory used by the in-memory part of the index in bytes",
+		[]string{"keyspace", "table", "index"}, nil,
+	)
+
+	PromIndexQueryLatency = prometheus.NewDesc(
+		"seastat_index_query_latency_seconds",
+		"Latency of looking up values in the index",
+		[]string{"keyspace", "table", "index"}, nil,
+	)
+
+	PromIndexTotalDiskSpaceUsed = prometheus.NewDesc(
+		"seastat_index_total_disk_space_used_bytes",
+		"Disk space used by all the data of the index in bytes",
+		[]string{"keyspace", "table", "index"}, nil,
+	)
+
+	PromIndexLiveSSTables = prometheus.NewDesc(
+		"seastat_index_live_sstables",
+		"Number of live SSTables backing the index",
+		[]string{"keyspace", "table", "index"}, nil,
+	)
+
+	PromIndexSSTableCells = prometheus.NewDesc(
+		"seastat_index_sstable_cells",
+		"Number of cells indexed across SSTables",
+		[]string{"keyspace", "table", "index"}, nil,
+	)
+
+	PromIndexMemtableIndexWrites = prometheus.NewDesc(
+		"seastat_index_memtable_writes",
+		"Number of writes to the index in the live memtable",
+		[]string{"keyspace", "table", "index"}, nil,
+	)
+
+	PromIndexMemtableIndexWriteLatency = prometheus.NewDesc(
+		"seastat_index_memtable_write_latency_seconds",
+		"Latency of writing to the memtable part of the index",
+		[]string{"keyspace", "table", "index"}, nil,
+	)
+
+	PromIndexMemtableIndexFlushes = prometheus.NewDesc(
+		"seastat_index_memtable_flushes_total",
+		"Number of times the memtable part of the index has been flushed",
+		[]string{"keyspace", "table", "index"}, nil,
+	)
+
+	PromIndexCompactions = prometheus.NewDesc(
+		"seastat_index_compactions_total",
+		"Number of compactions the index has taken part in",
+		[]string{"keyspace", "table", "index"}, nil,
+	)
+
+	PromIndexSegmentsPerCompaction = prometheus.NewDesc(
+		"seastat_index_segments_per_compaction",
+		"Number of index segments written per compaction",
+		[]string{"keyspace", "table", "index"}, nil,
+	)
+)
+
+// MemtablePoolStats
+var (
+	PromMemtablePoolBlockedOnAllocation = prometheus.NewDesc(
+		"seastat_memtable_pool_blocked_on_allocation_total",
+		"Number of times writes were blocked waiting for memtable memory to be allocated",
+		[]string{}, nil,
+	)
+
+	PromMemtablePoolWaitingOnFreeSpace = prometheus.NewDesc(
+		"seastat_memtable_pool_waiting_on_free_space_seconds",
+		"Time spent waiting for free memtable space",
+		[]string{}, nil,
+	)
 )
 
 // CQLStats
@@ -197,6 +584,27 @@ var (
 	)
 )
 
+// BatchStats
+var (
+	PromBatchPartitionsPerLoggedBatch = prometheus.NewDesc(
+		"seastat_batch_partitions_per_logged_batch",
+		"Number of partitions touched per logged batch",
+		[]string{}, nil,
+	)
+
+	PromBatchPartitionsPerUnloggedBatch = prometheus.NewDesc(
+		"seastat_batch_partitions_per_unlogged_batch",
+		"Number of partitions touched per unlogged batch",
+		[]string{}, nil,
+	)
+
+	PromBatchPartitionsPerCounterBatch = prometheus.NewDesc(
+		"seastat_batch_partitions_per_counter_batch",
+		"Number of partitions touched per counter batch",
+		[]string{}, nil,
+	)
+)
+
 // ThreadPoolStats
 var (
 	PromThreadPoolActiveTasks = prometheus.NewDesc(
@@ -257,31 +665,112 @@ var (
 	)
 )
 
+// ActiveCompactions
+var (
+	PromCompactionsRunning = prometheus.NewDesc(
+		"seastat_compaction_running_tasks",
+		"Number of compaction tasks currently running on the node",
+		[]string{}, nil,
+	)
+
+	PromActiveCompactionRunning = prometheus.NewDesc(
+		"seastat_compaction_active_table_tasks",
+		"Number of compaction tasks currently running for a table and task type",
+		[]string{"keyspace", "table", "task_type", "unit"}, nil,
+	)
+
+	PromActiveCompactionCompleted = prometheus.NewDesc(
+		"seastat_compaction_active_completed",
+		"Progress of the running compactions for a table and task type (in the given unit)",
+		[]string{"keyspace", "table", "task_type", "unit"}, nil,
+	)
+
+	PromActiveCompactionTotal = prometheus.NewDesc(
+		"seastat_compaction_active_size",
+		"Total size of the running compactions for a table and task type (in the given unit)",
+		[]string{"keyspace", "table", "task_type", "unit"}, nil,
+	)
+)
+
+// CompactionHistory
+var (
+	PromCompactionHistoryCompactions = prometheus.NewDesc(
+		"seastat_table_compactions_completed_total",
+		"Number of compactions completed for the table since Seastat started",
+		[]string{"keyspace", "table"}, nil,
+	)
+
+	PromCompactionHistoryBytesIn = prometheus.NewDesc(
+		"seastat_table_compaction_bytes_in_total",
+		"Bytes read by completed compactions for the table since Seastat started",
+		[]string{"keyspace", "table"}, nil,
+	)
+
+	PromCompactionHistoryBytesOut = prometheus.NewDesc(
+		"seastat_table_compaction_bytes_out_total",
+		"Bytes written by completed compactions for the table since Seastat started",
+		[]string{"keyspace", "table"}, nil,
+	)
+)
+
 // ClientRequestStats
 var (
 	PromClientRequestLatency = prometheus.NewDesc(
 		"seastat_client_request_latency_seconds",
 		"Coordinator request latency",
-		[]string{"request_type"}, nil,
+		[]string{"request_type", "consistency_level"}, nil,
 	)
 
 	PromClientRequestTimeouts = prometheus.NewDesc(
 		"seastat_client_request_timeout_total",
 		"Total number of coordinated request timeouts",
-		[]string{"request_type"}, nil,
+		[]string{"request_type", "consistency_level"}, nil,
 	)
 
 	PromClientRequestFailures = prometheus.NewDesc(
 		"seastat_client_request_failure_total",
 		"Total number of coordinated request failures",
-		[]string{"request_type"}, nil,
+		[]string{"request_type", "consistency_level"}, nil,
 	)
 
 	PromClientRequestUnavailable = prometheus.NewDesc(
 		"seastat_client_request_unavailable_total",
 		"Total number of coordinated request unavailable",
+		[]string{"request_type", "consistency_level"}, nil,
+	)
+)
+
+// CASRequestStats
+var (
+	PromCASRequestContention = prometheus.NewDesc(
+		"seastat_client_request_cas_contention",
+		"Number of Paxos rounds needed per CAS request due to contention",
+		[]string{"request_type"}, nil,
+	)
+
+	PromCASRequestUnfinishedCommit = prometheus.NewDesc(
+		"seastat_client_request_cas_unfinished_commit_total",
+		"Total number of CAS requests which found an unfinished Paxos commit",
+		[]string{"request_type"}, nil,
+	)
+
+	PromCASRequestConditionNotMet = prometheus.NewDesc(
+		"seastat_client_request_cas_condition_not_met_total",
+		"Total number of CAS writes where the condition was not met",
+		[]string{"request_type"}, nil,
+	)
+
+	PromCASRequestUnknownResult = prometheus.NewDesc(
+		"seastat_client_request_cas_unknown_result_total",
+		"Total number of CAS requests with an unknown result",
 		[]string{"request_type"}, nil,
 	)
+
+	PromCASRequestPaxosRepairs = prometheus.NewDesc(
+		"seastat_client_request_cas_paxos_repairs_total",
+		"Total count of each of the Paxos repair meters",
+		[]string{"request_type", "meter"}, nil,
+	)
 )
 
 // ConnectedClientStats
@@ -293,6 +782,87 @@ var (
 	)
 )
 
+// ClientConnectionStats
+var (
+	PromClientConnectionsByUser = prometheus.NewDesc(
+		"seastat_connected_clients_by_user",
+		"Number of connected clients per user",
+		[]string{"user"}, nil,
+	)
+
+	PromClientConnectionsByDriver = prometheus.NewDesc(
+		"seastat_connected_clients_by_driver",
+		"Number of connected clients per driver name and version",
+		[]string{"driver_name", "driver_version"}, nil,
+	)
+
+	PromClientConnectionsByProtocolVersion = prometheus.NewDesc(
+		"seastat_connected_clients_by_protocol_version",
+		"Number of connected clients per native protocol version",
+		[]string{"protocol_version"}, nil,
+	)
+)
+
+// NativeTransportStats
+var (
+	PromNativeTransportPausedConnections = prometheus.NewDesc(
+		"seastat_native_transport_paused_connections",
+		"Number of client connections paused due to backpressure",
+		[]string{}, nil,
+	)
+
+	PromNativeTransportRequestsDiscarded = prometheus.NewDesc(
+		"seastat_native_transport_requests_discarded_total",
+		"Total number of client requests discarded due to overload",
+		[]string{}, nil,
+	)
+
+	PromNativeTransportQueuedRequests = prometheus.NewDesc(
+		"seastat_native_transport_queued_requests",
+		"Number of client requests waiting to be processed",
+		[]string{}, nil,
+	)
+
+	PromNativeTransportRequestsInFlight = prometheus.NewDesc(
+		"seastat_native_transport_requests_in_flight_bytes",
+		"Bytes of client requests currently being processed",
+		[]string{}, nil,
+	)
+
+	PromNativeTransportRequestSize = prometheus.NewDesc(
+		"seastat_native_transport_request_size_bytes",
+		"Size of client requests in bytes",
+		[]string{}, nil,
+	)
+)
+
+// AuthCacheStats
+var (
+	PromAuthCacheHits = prometheus.NewDesc(
+		"seastat_auth_cache_hits_total",
+		"Total number of auth cache hits",
+		[]string{"cache"}, nil,
+	)
+
+	PromAuthCacheMisses = prometheus.NewDesc(
+		"seastat_auth_cache_misses_total",
+		"Total number of auth cache misses",
+		[]string{"cache"}, nil,
+	)
+
+	PromAuthCacheSize = prometheus.NewDesc(
+		"seastat_auth_cache_size",
+		"Number of entries in the auth cache",
+		[]string{"cache"}, nil,
+	)
+
+	PromAuthCacheCapacity = prometheus.NewDesc(
+		"seastat_auth_cache_capacity",
+		"Maximum number of entries in the auth cache",
+		[]string{"cache"}, nil,
+	)
+)
+
 // MemoryStats
 var (
 	PromMemoryStatsHeapUsed = prometheus.NewDesc(
@@ -301,11 +871,215 @@ var (
 		[]string{}, nil,
 	)
 
+	PromMemoryStatsHeapCommitted = prometheus.NewDesc(
+		"seastat_memory_heap_committed_bytes",
+		"Bytes of heap memory committed for use by the JVM",
+		[]string{}, nil,
+	)
+
+	PromMemoryStatsHeapMax = prometheus.NewDesc(
+		"seastat_memory_heap_max_bytes",
+		"Maximum bytes of heap memory the JVM can use",
+		[]string{}, nil,
+	)
+
 	PromMemoryStatsNonHeapUsed = prometheus.NewDesc(
 		"seastat_memory_nonheap_used_bytes",
 		"Bytes representing the used memory non-heap size",
 		[]string{}, nil,
 	)
+
+	PromMemoryStatsNonHeapCommitted = prometheus.NewDesc(
+		"seastat_memory_nonheap_committed_bytes",
+		"Bytes of non-heap memory committed for use by the JVM",
+		[]string{}, nil,
+	)
+)
+
+// JVMStats
+var (
+	PromJVMInfo = prometheus.NewDesc(
+		"seastat_jvm_info",
+		"Information about the JVM running Cassandra, the value is always 1",
+		[]string{"vendor", "version", "input_arguments_hash"}, nil,
+	)
+
+	PromJVMStartTime = prometheus.NewDesc(
+		"seastat_jvm_start_time_seconds",
+		"Time the JVM was started as a unix timestamp",
+		[]string{}, nil,
+	)
+
+	PromJVMUptime = prometheus.NewDesc(
+		"seastat_jvm_uptime_seconds",
+		"Time since the JVM was started",
+		[]string{}, nil,
+	)
+
+	PromJVMThreads = prometheus.NewDesc(
+		"seastat_jvm_threads",
+		"Number of live threads including daemon threads",
+		[]string{}, nil,
+	)
+
+	PromJVMDaemonThreads = prometheus.NewDesc(
+		"seastat_jvm_daemon_threads",
+		"Number of live daemon threads",
+		[]string{}, nil,
+	)
+
+	PromJVMPeakThreads = prometheus.NewDesc(
+		"seastat_jvm_peak_threads",
+		"Peak number of live threads since the JVM started",
+		[]string{}, nil,
+	)
+
+	PromJVMThreadsStarted = prometheus.NewDesc(
+		"seastat_jvm_threads_started_total",
+		"Total number of threads started since the JVM started",
+		[]string{}, nil,
+	)
+
+	PromJVMLoadedClasses = prometheus.NewDesc(
+		"seastat_jvm_classes_current",
+		"Number of classes currently loaded",
+		[]string{}, nil,
+	)
+
+	PromJVMClassesLoaded = prometheus.NewDesc(
+		"seastat_jvm_classes_loaded_total",
+		"Total number of classes loaded since the JVM started",
+		[]string{}, nil,
+	)
+
+	PromJVMClassesUnloaded = prometheus.NewDesc(
+		"seastat_jvm_classes_unloaded_total",
+		"Total number of classes unloaded since the JVM started",
+		[]string{}, nil,
+	)
+
+	PromJVMCompilationTime = prometheus.NewDesc(
+		"seastat_jvm_compilation_seconds_total",
+		"Total time spent in JIT compilation",
+		[]string{}, nil,
+	)
+)
+
+// MemoryPoolStats
+var (
+	PromMemoryPoolUsed = prometheus.NewDesc(
+		"seastat_memory_pool_used_bytes",
+		"Bytes used in the memory pool",
+		[]string{"name", "type"}, nil,
+	)
+
+	PromMemoryPoolCommitted = prometheus.NewDesc(
+		"seastat_memory_pool_committed_bytes",
+		"Bytes committed for use by the memory pool",
+		[]string{"name", "type"}, nil,
+	)
+
+	PromMemoryPoolMax = prometheus.NewDesc(
+		"seastat_memory_pool_max_bytes",
+		"Maximum bytes the memory pool can use",
+		[]string{"name", "type"}, nil,
+	)
+
+	PromMemoryPoolPostGCUsed = prometheus.NewDesc(
+		"seastat_memory_pool_post_gc_used_bytes",
+		"Bytes used in the memory pool after the most recent garbage collection",
+		[]string{"name", "type"}, nil,
+	)
+)
+
+// BufferPoolStats
+var (
+	PromBufferPoolBuffers = prometheus.NewDesc(
+		"seastat_buffer_pool_buffers",
+		"Number of buffers in the buffer pool",
+		[]string{"name"}, nil,
+	)
+
+	PromBufferPoolUsed = prometheus.NewDesc(
+		"seastat_buffer_pool_used_bytes",
+		"Bytes of memory used by the buffer pool",
+		[]string{"name"}, nil,
+	)
+
+	PromBufferPoolCapacity = prometheus.NewDesc(
+		"seastat_buffer_pool_capacity_bytes",
+		"Total capacity of the buffers in the buffer pool in bytes",
+		[]string{"name"}, nil,
+	)
+)
+
+// OperatingSystemStats
+var (
+	PromProcessOpenFDs = prometheus.NewDesc(
+		"seastat_process_open_fds",
+		"Number of open file descriptors of the Cassandra process",
+		[]string{}, nil,
+	)
+
+	PromProcessMaxFDs = prometheus.NewDesc(
+		"seastat_process_max_fds",
+		"Maximum number of open file descriptors allowed for the Cassandra process",
+		[]string{}, nil,
+	)
+
+	PromProcessCPUTime = prometheus.NewDesc(
+		"seastat_process_cpu_seconds_total",
+		"CPU time used by the Cassandra process",
+		[]string{}, nil,
+	)
+
+	PromProcessCPULoad = prometheus.NewDesc(
+		"seastat_process_cpu_load",
+		"Recent CPU usage of the Cassandra process between 0 and 1",
+		[]string{}, nil,
+	)
+
+	PromOSCPULoad = prometheus.NewDesc(
+		"seastat_os_cpu_load",
+		"Recent CPU usage of the whole system between 0 and 1",
+		[]string{}, nil,
+	)
+
+	PromOSLoadAverage = prometheus.NewDesc(
+		"seastat_os_load_average_1m",
+		"System load average over the last minute",
+		[]string{}, nil,
+	)
+
+	PromOSAvailableProcessors = prometheus.NewDesc(
+		"seastat_os_available_processors",
+		"Number of processors available to the JVM",
+		[]string{}, nil,
+	)
+
+	PromOSMemoryFree = prometheus.NewDesc(
+		"seastat_os_memory_free_bytes",
+		"Free physical memory in bytes",
+		[]string{}, nil,
+	)
+
+	PromOSMemoryTotal = prometheus.NewDesc(
+		"seastat_os_memory_total_bytes",
+		"Total physical memory in bytes",
+		[]string{}, nil,
+	)
+
+	PromOSSwapFree = prometheus.NewDesc(
+		"seastat_os_swap_free_bytes",
+		"Free swap space in bytes",
+		[]string{}, nil,
+	)
+
+	PromOSSwapTotal = prometheus.NewDesc(
+		"seastat_os_swap_total_bytes",
+		"Total swap space in bytes",
+		[]string{}, nil,
+	)
 )
 
 // GCStats
@@ -356,6 +1130,21 @@ var (
 	)
 )
 
+// SnapshotStats
+var (
+	PromSnapshotsTrueSize = prometheus.NewDesc(
+		"seastat_snapshots_true_size_bytes",
+		"Disk space used by snapshots which is not shared with live data in bytes",
+		[]string{}, nil,
+	)
+
+	PromTableSnapshots = prometheus.NewDesc(
+		"seastat_table_snapshots",
+		"Number of snapshots of this table",
+		[]string{"keyspace", "table"}, nil,
+	)
+)
+
 var (
 	PromStorageInternalExceptions = prometheus.NewDesc(
 		"seastat_internal_exceptions",
@@ -375,3 +1164,126 @@ var (
 		[]string{}, nil,
 	)
 )
+
+// MessagingStats
+var (
+	PromMessagingCrossNodeLatency = prometheus.NewDesc(
+		"seastat_messaging_cross_node_latency_seconds",
+		"Internode messaging latency for messages received from all nodes",
+		[]string{}, nil,
+	)
+
+	PromMessagingDatacenterLatency = prometheus.NewDesc(
+		"seastat_messaging_datacenter_latency_seconds",
+		"Internode messaging latency for messages received from a datacenter",
+		[]string{"datacenter"}, nil,
+	)
+)
+
+// HintsStats
+var (
+	PromHintsSucceeded = prometheus.NewDesc(
+		"seastat_hints_succeeded_total",
+		"Number of hints successfully delivered",
+		[]string{}, nil,
+	)
+
+	PromHintsFailed = prometheus.NewDesc(
+		"seastat_hints_failed_total",
+		"Number of hints which failed delivery",
+		[]string{}, nil,
+	)
+
+	PromHintsTimedOut = prometheus.NewDesc(
+		"seastat_hints_timed_out_total",
+		"Number of hints which timed out during delivery",
+		[]string{}, nil,
+	)
+
+	PromHintsDelay = prometheus.NewDesc(
+		"seastat_hints_delay_seconds",
+		"Delay between a hint being created and delivered to an endpoint",
+		[]string{"endpoint"}, nil,
+	)
+)
+
+// ReadRepairStats
+var (
+	PromReadRepairRepairedBlocking = prometheus.NewDesc(
+		"seastat_read_repair_repaired_blocking_total",
+		"Number of read repairs performed in the foreground of a read",
+		[]string{}, nil,
+	)
+
+	PromReadRepairRepairedBackground = prometheus.NewDesc(
+		"seastat_read_repair_repaired_background_total",
+		"Number of read repairs performed in the background of a read",
+		[]string{}, nil,
+	)
+
+	PromReadRepairAttempted = prometheus.NewDesc(
+		"seastat_read_repair_attempted_total",
+		"Number of read repairs attempted",
+		[]string{}, nil,
+	)
+
+	PromReadRepairSpeculatedRead = prometheus.NewDesc(
+		"seastat_read_repair_speculated_read_total",
+		"Number of speculative read repair reads",
+		[]string{}, nil,
+	)
+
+	PromReadRepairSpeculatedWrite = prometheus.NewDesc(
+		"seastat_read_repair_speculated_write_total",
+		"Number of speculative read repair writes",
+		[]string{}, nil,
+	)
+
+	PromReadRepairReconcileRead = prometheus.NewDesc(
+		"seastat_read_repair_reconcile_read_total",
+		"Number of read repairs which required reconciling data between replicas",
+		[]string{}, nil,
+	)
+)
+
+// RepairStats
+var (
+	PromRepairSessions = prometheus.NewDesc(
+		"seastat_repair_sessions",
+		"Number of incremental repair sessions which haven't finished yet",
+		[]string{"state"}, nil,
+	)
+
+	PromRepairPreviewFailures = prometheus.NewDesc(
+		"seastat_repair_preview_failures_total",
+		"Number of preview repairs which found inconsistencies",
+		[]string{}, nil,
+	)
+)
+
+// ViewWriteStats
+var (
+	PromViewWriteReplicasAttempted = prometheus.NewDesc(
+		"seastat_view_write_replicas_attempted_total",
+		"Number of materialized view replica updates attempted",
+		[]string{}, nil,
+	)
+
+	PromViewWriteReplicasSuccess = prometheus.NewDesc(
+		"seastat_view_write_replicas_success_total",
+		"Number of materialized view replica updates which succeeded",
+		[]string{}, nil,
+	)
+
+	PromViewWritePendingMutations = prometheus.NewDesc(
+		"seastat_view_write_pending_mutations",
+		"Number of materialized view replica updates which have been sent but not yet acknowledged",
+		[]string{}, nil,
+	)
+
+	PromViewWriteLatency = prometheus.NewDesc(
+		"seastat_view_write_latency_seconds",
+		"Time between a base table write and all of its materialized view updates being applied",
+		[]string{}, nil,
+	)
+)
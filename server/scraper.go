@@ -18,6 +18,7 @@ const tableScrapeInterval = 5 * time.Minute
 type Scraper struct {
 	client         jolokia.Client
 	maxConcurrency int
+	options        ScrapeOptions
 	stopped        chan struct{}
 
 	// Everything below should use the mutex
@@ -25,24 +26,86 @@ type Scraper struct {
 
 	// Keep track of all our tables and when we last scraped them
 	tables          []jolokia.Table
+	indexes         []jolokia.Index
+	views           map[jolokia.Table]bool
+	tableConfigs    map[jolokia.Table]jolokia.TableConfig
 	lastTableScrape time.Time
 
 	metrics           ScrapedMetrics
 	lastMetricsScrape time.Time
+
+	// Compaction history is the whole system.compaction_history table so it
+	// is only read when we refresh our tables and is diffed between reads.
+	// We keep track of the compactions we've already seen, these are only
+	// used by the scrape loop
+	compactionHistory       []CompactionHistoryStats
+	compactionHistorySeen   map[string]struct{}
+	compactionHistoryTotals map[jolokia.Table]*CompactionHistoryStats
+
+	// Snapshot stats walk the data directories on disk so they are also
+	// only read when we refresh our tables
+	snapshotStats *jolokia.SnapshotStats
+}
+
+// CompactionHistoryStats holds the running totals of compactions that have
+// completed for a table since Seastat started
+type CompactionHistoryStats struct {
+	Table       jolokia.Table
+	Compactions jolokia.Counter
+	BytesIn     jolokia.Counter
+	BytesOut    jolokia.Counter
+}
+
+// ScrapeOptions holds the optional behaviours of the scraper which may
+// increase the number of metrics exported
+type ScrapeOptions struct {
+	// ConsistencyLevels keeps the per consistency level client request stats
+	// (Cassandra 4.0+) rather than discarding them
+	ConsistencyLevels bool
+
+	// ClientConnections scrapes the list of connected clients so connections
+	// can be broken down by user, driver and protocol version
+	ClientConnections bool
+
+	// ClientConnectionsTopN caps how many groups are exported for each of
+	// the client connection breakdowns. The remainder are exported as other
+	ClientConnectionsTopN int
 }
 
 // ScrapedMetrics holds all the metrics we've scraped
 type ScrapedMetrics struct {
-	TableStats         []jolokia.TableStats
-	CQLStats           *jolokia.CQLStats
-	ThreadPoolStats    []jolokia.ThreadPoolStats
-	CompactionStats    *jolokia.CompactionStats
-	ClientRequestStats []jolokia.ClientRequestStats
-	ConnectedClients   *jolokia.Gauge
-	MemoryStats        *jolokia.MemoryStats
-	GCStats            []jolokia.GCStats
-	StorageStats       *jolokia.StorageStats
-	StorageCoreStats   *jolokia.StorageCoreStats
+	TableStats            []jolokia.TableStats
+	TableConfigs          map[jolokia.Table]jolokia.TableConfig
+	Views                 map[jolokia.Table]bool
+	IndexStats            []jolokia.IndexStats
+	KeyspaceStats         []jolokia.KeyspaceStats
+	MemtablePoolStats     *jolokia.MemtablePoolStats
+	CQLStats              *jolokia.CQLStats
+	BatchStats            *jolokia.BatchStats
+	ThreadPoolStats       []jolokia.ThreadPoolStats
+	CompactionStats       *jolokia.CompactionStats
+	ActiveCompactions     []jolokia.ActiveCompaction
+	CompactionHistory     []CompactionHistoryStats
+	ClientRequestStats    []jolokia.ClientRequestStats
+	CASRequestStats       []jolokia.CASRequestStats
+	ConnectedClients      *jolokia.Gauge
+	ClientConnectionStats *jolokia.ClientConnectionStats
+	NativeTransportStats  *jolokia.NativeTransportStats
+	AuthCacheStats        []jolokia.AuthCacheStats
+	MemoryStats           *jolokia.MemoryStats
+	JVMStats              *jolokia.JVMStats
+	MemoryPoolStats       []jolokia.MemoryPoolStats
+	BufferPoolStats       []jolokia.BufferPoolStats
+	OperatingSystemStats  *jolokia.OperatingSystemStats
+	GCStats               []jolokia.GCStats
+	StorageStats          *jolokia.StorageStats
+	SnapshotStats         *jolokia.SnapshotStats
+	StorageCoreStats      *jolokia.StorageCoreStats
+	MessagingStats        *jolokia.MessagingStats
+	HintsStats            *jolokia.HintsStats
+	RepairStats           *jolokia.RepairStats
+	ReadRepairStats       *jolokia.ReadRepairStats
+	ViewWriteStats        *jolokia.ViewWriteStats
 
 	ScrapeDuration time.Duration
 	ScrapeTime     time.Time
@@ -60,10 +123,11 @@ func (t TableStatsSorter) Less(i, j int) bool {
 }
 
 // NewScraper returns a new instance of a Scraper
-func NewScraper(client jolokia.Client, maxConcurrency int) *Scraper {
+func NewScraper(client jolokia.Client, maxConcurrency int, options ScrapeOptions) *Scraper {
 	return &Scraper{
 		client:         client,
 		maxConcurrency: maxConcurrency,
+		options:        options,
 		stopped:        make(chan struct{}),
 	}
 }
@@ -113,12 +177,46 @@ func (s *Scraper) runScrape() {
 			return
 		}
 
+		// Not being able to find indexes isn't worth bailing out over, we
+		// keep scraping whichever indexes we already knew about
+		indexes, err := s.client.Indexes()
+		if err != nil {
+			logrus.Debugf("🦂 Could not refresh indexes: %v", err)
+			indexes = s.indexes
+		}
+
+		views := s.refreshViews(tables)
+
+		tableConfigs := s.scrapeTableConfigs(tables)
+
+		compactionHistory := s.compactionHistory
+		compactionHistoryEntries, err := s.client.CompactionHistory()
+		if err != nil {
+			logrus.Debugf("🦂 Could not get Compaction History: %v", err)
+		} else {
+			compactionHistory = s.updateCompactionHistory(compactionHistoryEntries)
+		}
+
+		snapshotStats := s.snapshotStats
+		newSnapshotStats, err := s.client.SnapshotStats()
+		if err != nil {
+			logrus.Debugf("🦂 Could not get Snapshot stats: %v", err)
+		} else {
+			snapshotStats = &newSnapshotStats
+		}
+
 		s.mu.Lock()
 		s.tables = tables
+		s.indexes = indexes
+		s.views = views
+		s.tableConfigs = tableConfigs
+		s.compactionHistory = compactionHistory
+		s.snapshotStats = snapshotStats
 		s.lastTableScrape = time.Now()
 		s.mu.Unlock()
 
-		logrus.Debugf("🐝 Refreshed table list, got %d tables (took %d ms)", len(s.tables), time.Since(start).Milliseconds())
+		logrus.Debugf("🐝 Refreshed table list, got %d tables and %d indexes (took %d ms)", len(s.tables),
+			len(s.indexes), time.Since(start).Milliseconds())
 	}
 
 	newScrapedMetrics := s.scrapeAllMetrics()
@@ -135,8 +233,25 @@ func (s *Scraper) scrapeAllMetrics() ScrapedMetrics {
 	scrapeStart := time.Now()
 	out := ScrapedMetrics{}
 
-	tableStats := s.scrapeTableMetrics()
+	tableStats, indexStats := s.scrapeTableMetrics()
 	out.TableStats = tableStats
+	out.IndexStats = indexStats
+	out.TableConfigs = s.tableConfigs
+	out.Views = s.views
+
+	keyspaceStats, err := s.client.KeyspaceStats()
+	if err != nil {
+		logrus.Debugf("🦂 Could not get Keyspace stats: %v", err)
+	} else {
+		out.KeyspaceStats = keyspaceStats
+	}
+
+	memtablePoolStats, err := s.client.MemtablePoolStats()
+	if err != nil {
+		logrus.Debugf("🦂 Could not get Memtable Pool stats: %v", err)
+	} else {
+		out.MemtablePoolStats = &memtablePoolStats
+	}
 
 	cqlStats, err := s.client.CQLStats()
 	if err != nil {
@@ -145,6 +260,13 @@ func (s *Scraper) scrapeAllMetrics() ScrapedMetrics {
 		out.CQLStats = &cqlStats
 	}
 
+	batchStats, err := s.client.BatchStats()
+	if err != nil {
+		logrus.Debugf("🦂 Could not get Batch stats: %v", err)
+	} else {
+		out.BatchStats = &batchStats
+	}
+
 	tpStats, err := s.client.ThreadPoolStats()
 	if err != nil {
 		logrus.Debugf("🦂 Could not get ThreadPool stats: %v", err)
@@ -159,11 +281,27 @@ func (s *Scraper) scrapeAllMetrics() ScrapedMetrics {
 		out.CompactionStats = &compactionStats
 	}
 
+	activeCompactions, err := s.client.ActiveCompactions()
+	if err != nil {
+		logrus.Debugf("🦂 Could not get Active Compactions: %v", err)
+	} else {
+		out.ActiveCompactions = activeCompactions
+	}
+
+	out.CompactionHistory = s.compactionHistory
+
 	clientReqStats, err := s.client.ClientRequestStats()
 	if err != nil {
 		logrus.Debugf("🦂 Could not get Client Request stats: %v", err)
 	} else {
-		out.ClientRequestStats = clientReqStats
+		out.ClientRequestStats = s.filterClientRequestStats(clientReqStats)
+	}
+
+	casReqStats, err := s.client.CASRequestStats()
+	if err != nil {
+		logrus.Debugf("🦂 Could not get CAS Request stats: %v", err)
+	} else {
+		out.CASRequestStats = casReqStats
 	}
 
 	connectedClients, err := s.client.ConnectedClients()
@@ -173,6 +311,32 @@ func (s *Scraper) scrapeAllMetrics() ScrapedMetrics {
 		out.ConnectedClients = &connectedClients
 	}
 
+	if s.options.ClientConnections {
+		clientConnectionStats, err := s.client.ClientConnectionStats()
+		if err != nil {
+			logrus.Debugf("🦂 Could not get Client Connection stats: %v", err)
+		} else {
+			clientConnectionStats.ByUser = capClientConnectionGroups(clientConnectionStats.ByUser, s.options.ClientConnectionsTopN)
+			clientConnectionStats.ByDriver = capClientConnectionGroups(clientConnectionStats.ByDriver, s.options.ClientConnectionsTopN)
+			clientConnectionStats.ByProtocolVersion = capClientConnectionGroups(clientConnectionStats.ByProtocolVersion, s.options.ClientConnectionsTopN)
+			out.ClientConnectionStats = &clientConnectionStats
+		}
+	}
+
+	nativeTransportStats, err := s.client.NativeTransportStats()
+	if err != nil {
+		logrus.Debugf("🦂 Could not get Native Transport stats: %v", err)
+	} else {
+		out.NativeTransportStats = &nativeTransportStats
+	}
+
+	authCacheStats, err := s.client.AuthCacheStats()
+	if err != nil {
+		logrus.Debugf("🦂 Could not get Auth Cache stats: %v", err)
+	} else {
+		out.AuthCacheStats = authCacheStats
+	}
+
 	memoryStats, err := s.client.MemoryStats()
 	if err != nil {
 		logrus.Debugf("🦂 Could not get Memory stats: %v", err)
@@ -180,6 +344,34 @@ func (s *Scraper) scrapeAllMetrics() ScrapedMetrics {
 		out.MemoryStats = &memoryStats
 	}
 
+	jvmStats, err := s.client.JVMStats()
+	if err != nil {
+		logrus.Debugf("🦂 Could not get JVM stats: %v", err)
+	} else {
+		out.JVMStats = &jvmStats
+	}
+
+	memoryPoolStats, err := s.client.MemoryPoolStats()
+	if err != nil {
+		logrus.Debugf("🦂 Could not get Memory Pool stats: %v", err)
+	} else {
+		out.MemoryPoolStats = memoryPoolStats
+	}
+
+	bufferPoolStats, err := s.client.BufferPoolStats()
+	if err != nil {
+		logrus.Debugf("🦂 Could not get Buffer Pool stats: %v", err)
+	} else {
+		out.BufferPoolStats = bufferPoolStats
+	}
+
+	osStats, err := s.client.OperatingSystemStats()
+	if err != nil {
+		logrus.Debugf("🦂 Could not get Operating System stats: %v", err)
+	} else {
+		out.OperatingSystemStats = &osStats
+	}
+
 	gcStats, err := s.client.GarbageCollectionStats()
 	if err != nil {
 		logrus.Debugf("🦂 Could not get GC stats: %v", err)
@@ -194,6 +386,8 @@ func (s *Scraper) scrapeAllMetrics() ScrapedMetrics {
 		out.StorageStats = &storageStats
 	}
 
+	out.SnapshotStats = s.snapshotStats
+
 	storageCoreStats, err := s.client.StorageCoreStats()
 	if err != nil {
 		logrus.Debugf("🦂 Could not get Storage Core stats: %v", err)
@@ -201,42 +395,179 @@ func (s *Scraper) scrapeAllMetrics() ScrapedMetrics {
 		out.StorageCoreStats = &storageCoreStats
 	}
 
+	messagingStats, err := s.client.MessagingStats()
+	if err != nil {
+		logrus.Debugf("🦂 Could not get Messaging stats: %v", err)
+	} else {
+		out.MessagingStats = &messagingStats
+	}
+
+	hintsStats, err := s.client.HintsStats()
+	if err != nil {
+		logrus.Debugf("🦂 Could not get Hints stats: %v", err)
+	} else {
+		out.HintsStats = &hintsStats
+	}
+
+	repairStats, err := s.client.RepairStats()
+	if err != nil {
+		logrus.Debugf("🦂 Could not get Repair stats: %v", err)
+	} else {
+		out.RepairStats = &repairStats
+	}
+
+	readRepairStats, err := s.client.ReadRepairStats()
+	if err != nil {
+		logrus.Debugf("🦂 Could not get Read Repair stats: %v", err)
+	} else {
+		out.ReadRepairStats = &readRepairStats
+	}
+
+	viewWriteStats, err := s.client.ViewWriteStats()
+	if err != nil {
+		logrus.Debugf("🦂 Could not get View Write stats: %v", err)
+	} else {
+		out.ViewWriteStats = &viewWriteStats
+	}
+
 	out.ScrapeDuration = time.Since(scrapeStart)
 	out.ScrapeTime = time.Now()
 	return out
 }
 
-func (s *Scraper) scrapeTableMetrics() []jolokia.TableStats {
-	// The goal of this function is to scrape the table metrics in parallel.
+// updateCompactionHistory adds any compactions we haven't seen before to our
+// running per table totals and returns a sorted copy of the totals. The first
+// time we see the history, we only record what's there so that compactions
+// from before Seastat started aren't counted
+func (s *Scraper) updateCompactionHistory(entries []jolokia.CompactionHistoryEntry) []CompactionHistoryStats {
+	firstScrape := s.compactionHistorySeen == nil
+	if firstScrape {
+		s.compactionHistoryTotals = map[jolokia.Table]*CompactionHistoryStats{}
+	}
+
+	// Compactions eventually fall out of the history so we rebuild our seen
+	// set each time to stop it from growing forever
+	seen := make(map[string]struct{}, len(entries))
+	for _, entry := range entries {
+		seen[entry.ID] = struct{}{}
+		if _, ok := s.compactionHistorySeen[entry.ID]; ok || firstScrape {
+			continue
+		}
+
+		totals, ok := s.compactionHistoryTotals[entry.Table]
+		if !ok {
+			totals = &CompactionHistoryStats{Table: entry.Table}
+			s.compactionHistoryTotals[entry.Table] = totals
+		}
+		totals.Compactions++
+		totals.BytesIn += entry.BytesIn
+		totals.BytesOut += entry.BytesOut
+	}
+	s.compactionHistorySeen = seen
+
+	out := make([]CompactionHistoryStats, 0, len(s.compactionHistoryTotals))
+	for _, totals := range s.compactionHistoryTotals {
+		out = append(out, *totals)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		a := fmt.Sprintf("%s.%s", out[i].Table.KeyspaceName, out[i].Table.TableName)
+		b := fmt.Sprintf("%s.%s", out[j].Table.KeyspaceName, out[j].Table.TableName)
+		return a < b
+	})
+	return out
+}
+
+// filterClientRequestStats discards the per consistency level client request
+// stats unless we've been asked to keep them
+func (s *Scraper) filterClientRequestStats(stats []jolokia.ClientRequestStats) []jolokia.ClientRequestStats {
+	if s.options.ConsistencyLevels {
+		return stats
+	}
+
+	out := make([]jolokia.ClientRequestStats, 0, len(stats))
+	for _, stat := range stats {
+		if stat.ConsistencyLevel == "" {
+			out = append(out, stat)
+		}
+	}
+	return out
+}
+
+// otherClientConnectionGroup is the name of the group which connections
+// beyond the top N are folded into
+const otherClientConnectionGroup = "other"
+
+// capClientConnectionGroups keeps the first topN groups (which are sorted by
+// number of connections) and folds the rest into a single other group so the
+// total number of connections is kept. A topN of zero or below keeps all. If
+// one of the kept groups already has the other group's labels, the rest are
+// folded into it so we never export the same series twice
+func capClientConnectionGroups(groups []jolokia.ClientConnectionGroup, topN int) []jolokia.ClientConnectionGroup {
+	if topN <= 0 || len(groups) <= topN {
+		return groups
+	}
+
+	var overflow jolokia.Gauge
+	for _, group := range groups[topN:] {
+		overflow += group.Connections
+	}
+
+	out := make([]jolokia.ClientConnectionGroup, 0, topN+1)
+	out = append(out, groups[:topN]...)
+	for idx := range out {
+		if out[idx].Name == otherClientConnectionGroup && out[idx].Version == "" {
+			out[idx].Connections += overflow
+			return out
+		}
+	}
+	return append(out, jolokia.ClientConnectionGroup{
+		Name:        otherClientConnectionGroup,
+		Connections: overflow,
+	})
+}
+
+func (s *Scraper) scrapeTableMetrics() ([]jolokia.TableStats, []jolokia.IndexStats) {
+	// The goal of this function is to scrape the table and index metrics in
+	// parallel. Both share the same pool of workers
 	workers := s.maxConcurrency
 	if workers < 1 {
 		workers = 1
 	}
 
+	type job struct {
+		table jolokia.Table
+		index *jolokia.Index
+	}
+
 	type result struct {
-		table      jolokia.Table
+		job        job
 		tableStats jolokia.TableStats
+		indexStats jolokia.IndexStats
 		err        error
 	}
 
-	workerCh := make(chan jolokia.Table, workers)
-	resultCh := make(chan result, len(s.tables))
+	workerCh := make(chan job, workers)
+	resultCh := make(chan result, len(s.tables)+len(s.indexes))
 
 	wg := sync.WaitGroup{}
 	workerFunc := func() {
 		for {
 			select {
-			case table := <-workerCh:
-				if table.KeyspaceName == "" && table.TableName == "" {
+			case j := <-workerCh:
+				if j.table.KeyspaceName == "" && j.table.TableName == "" && j.index == nil {
 					return // closed channel
 				}
 
-				stats, err := s.client.TableStats(table)
-				resultCh <- result{
-					table:      table,
-					tableStats: stats,
-					err:        err,
+				res := result{job: j}
+				if j.index != nil {
+					res.indexStats, res.err = s.client.IndexStats(*j.index)
+				} else {
+					res.tableStats, res.err = s.client.TableStats(j.table)
+					if res.err == nil && s.hasSSTableLayout(j.table) {
+						s.addSSTableLayout(&res.tableStats)
+					}
 				}
+				resultCh <- res
 				wg.Done()
 			}
 		}
@@ -247,26 +578,152 @@ func (s *Scraper) scrapeTableMetrics() []jolokia.TableStats {
 	}
 	for _, table := range s.tables {
 		wg.Add(1)
-		workerCh <- table
+		workerCh <- job{table: table}
+	}
+	for idx := range s.indexes {
+		wg.Add(1)
+		workerCh <- job{index: &s.indexes[idx]}
 	}
 	wg.Wait()
 	close(workerCh)
 	close(resultCh)
 
 	tableStats := make([]jolokia.TableStats, 0, len(s.tables))
+	indexStats := make([]jolokia.IndexStats, 0, len(s.indexes))
 	for res := range resultCh {
 		// Occassionally, we might not be abkle to fetch table stats for a
 		// table. This isn't the end of the world
+		switch {
+		case res.job.index != nil && res.err != nil:
+			logrus.Debugf("🦂 Could not get index stats for %s.%s.%s: %v", res.job.index.Table.KeyspaceName,
+				res.job.index.Table.TableName, res.job.index.IndexName, res.err)
+		case res.job.index != nil:
+			indexStats = append(indexStats, res.indexStats)
+		case res.err != nil:
+			logrus.Debugf("🦂 Could not get table stats for %s.%s: %v", res.job.table.KeyspaceName,
+				res.job.table.TableName, res.err)
+		default:
+			tableStats = append(tableStats, res.tableStats)
+		}
+	}
+	sort.Sort(TableStatsSorter(tableStats))
+	sort.Slice(indexStats, func(i, j int) bool {
+		a := fmt.Sprintf("%s.%s.%s", indexStats[i].Index.Table.KeyspaceName, indexStats[i].Index.Table.TableName, indexStats[i].Index.IndexName)
+		b := fmt.Sprintf("%s.%s.%s", indexStats[j].Index.Table.KeyspaceName, indexStats[j].Index.Table.TableName, indexStats[j].Index.IndexName)
+		return a < b
+	})
+
+	return tableStats, indexStats
+}
+
+// hasSSTableLayout tells us whether a table spreads its SSTables across
+// levels or time windows based on its compaction strategy. If we don't know
+// the compaction strategy we assume it does
+func (s *Scraper) hasSSTableLayout(table jolokia.Table) bool {
+	config, ok := s.tableConfigs[table]
+	if !ok {
+		return true
+	}
+	return config.CompactionStrategy == "LeveledCompactionStrategy" ||
+		config.CompactionStrategy == "TimeWindowCompactionStrategy"
+}
+
+// addSSTableLayout adds the SSTable layout to the table stats. This is best
+// effort, if we can't get it we still want to keep the rest of the stats
+func (s *Scraper) addSSTableLayout(stats *jolokia.TableStats) {
+	layout, err := s.client.SSTableLayout(stats.Table)
+	if err != nil {
+		logrus.Debugf("🦂 Could not get sstable layout for %s.%s: %v", stats.Table.KeyspaceName,
+			stats.Table.TableName, err)
+		return
+	}
+	stats.SSTablesPerLevel = layout.PerLevel
+	stats.SSTablesPerWindow = layout.PerWindow
+}
+
+// refreshViews works out which of the given tables are materialized views.
+// A table can't become a view (or stop being one) so we only ask Cassandra
+// about tables we don't already know about and keep the rest
+func (s *Scraper) refreshViews(tables []jolokia.Table) map[jolokia.Table]bool {
+	views := make(map[jolokia.Table]bool, len(tables))
+	unknown := []jolokia.Table{}
+	for _, table := range tables {
+		if isView, ok := s.views[table]; ok {
+			views[table] = isView
+		} else {
+			unknown = append(unknown, table)
+		}
+	}
+
+	newViews, err := s.client.Views(unknown)
+	if err != nil {
+		logrus.Debugf("🦂 Could not refresh views: %v", err)
+		return views
+	}
+	for table, isView := range newViews {
+		views[table] = isView
+	}
+	return views
+}
+
+// scrapeTableConfigs reads the configuration of each of the given tables in
+// parallel. If we can't read the configuration of a table, we keep whatever
+// we had for it from the last refresh
+func (s *Scraper) scrapeTableConfigs(tables []jolokia.Table) map[jolokia.Table]jolokia.TableConfig {
+	workers := s.maxConcurrency
+	if workers < 1 {
+		workers = 1
+	}
+
+	type result struct {
+		table  jolokia.Table
+		config jolokia.TableConfig
+		err    error
+	}
+
+	workerCh := make(chan jolokia.Table, workers)
+	resultCh := make(chan result, len(tables))
+
+	wg := sync.WaitGroup{}
+	workerFunc := func() {
+		for {
+			select {
+			case table := <-workerCh:
+				if table.KeyspaceName == "" && table.TableName == "" {
+					return // closed channel
+				}
+
+				config, err := s.client.TableConfig(table)
+				resultCh <- result{table: table, config: config, err: err}
+				wg.Done()
+			}
+		}
+	}
+
+	for i := 0; i < workers; i++ {
+		go workerFunc()
+	}
+	for _, table := range tables {
+		wg.Add(1)
+		workerCh <- table
+	}
+	wg.Wait()
+	close(workerCh)
+	close(resultCh)
+
+	configs := make(map[jolokia.Table]jolokia.TableConfig, len(tables))
+	for res := range resultCh {
 		if res.err != nil {
-			logrus.Debugf("🦂 Could not get table stats for %s.%s: %v", res.table.KeyspaceName,
+			logrus.Debugf("🦂 Could not get table config for %s.%s: %v", res.table.KeyspaceName,
 				res.table.TableName, res.err)
+			if config, ok := s.tableConfigs[res.table]; ok {
+				configs[res.table] = config
+			}
 			continue
 		}
-		tableStats = append(tableStats, res.tableStats)
+		configs[res.table] = res.config
 	}
-	sort.Sort(TableStatsSorter(tableStats))
-
-	return tableStats
+	return configs
 }
 
 // Stop informs the scraper to stop scraping any further
@@ -0,0 +1,96 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/suhailpatel/seastat/jolokia"
+)
+
+func TestCapClientConnectionGroups(t *testing.T) {
+	groups := []jolokia.ClientConnectionGroup{
+		{Name: "app", Connections: 10},
+		{Name: "cassandra", Connections: 5},
+		{Name: "reporting", Connections: 3},
+		{Name: "batch", Connections: 2},
+	}
+
+	cases := []struct {
+		name string
+		topN int
+		out  []jolokia.ClientConnectionGroup
+	}{
+		{name: "disabled", topN: 0, out: groups},
+		{name: "under limit", topN: 4, out: groups},
+		{
+			name: "over limit",
+			topN: 2,
+			out: []jolokia.ClientConnectionGroup{
+				{Name: "app", Connections: 10},
+				{Name: "cassandra", Connections: 5},
+				{Name: "other", Connections: 5},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.out, capClientConnectionGroups(groups, tc.topN))
+		})
+	}
+}
+
+func TestCapClientConnectionGroupsOtherCollision(t *testing.T) {
+	// A real group called other is kept in the top N so the rest must be
+	// folded into it rather than emitted as a second other group
+	groups := []jolokia.ClientConnectionGroup{
+		{Name: "app", Connections: 10},
+		{Name: "other", Connections: 5},
+		{Name: "reporting", Connections: 3},
+		{Name: "batch", Connections: 2},
+	}
+	assert.Equal(t, []jolokia.ClientConnectionGroup{
+		{Name: "app", Connections: 10},
+		{Name: "other", Connections: 10},
+	}, capClientConnectionGroups(groups, 2))
+
+	// A real group called other beyond the top N is folded like any other
+	groups = []jolokia.ClientConnectionGroup{
+		{Name: "app", Connections: 10},
+		{Name: "reporting", Connections: 3},
+		{Name: "other", Connections: 2},
+	}
+	assert.Equal(t, []jolokia.ClientConnectionGroup{
+		{Name: "app", Connections: 10},
+		{Name: "other", Connections: 5},
+	}, capClientConnectionGroups(groups, 1))
+
+	// Drivers are labelled by name and version so a driver called other
+	// with a version doesn't collide with the other group
+	groups = []jolokia.ClientConnectionGroup{
+		{Name: "other", Version: "1.0", Connections: 10},
+		{Name: "java", Version: "4.0", Connections: 3},
+	}
+	assert.Equal(t, []jolokia.ClientConnectionGroup{
+		{Name: "other", Version: "1.0", Connections: 10},
+		{Name: "other", Connections: 3},
+	}, capClientConnectionGroups(groups, 1))
+}
+
+func TestHasSSTableLayout(t *testing.T) {
+	lcs := jolokia.Table{KeyspaceName: "ks", TableName: "lcs"}
+	twcs := jolokia.Table{KeyspaceName: "ks", TableName: "twcs"}
+	stcs := jolokia.Table{KeyspaceName: "ks", TableName: "stcs"}
+	unknown := jolokia.Table{KeyspaceName: "ks", TableName: "unknown"}
+
+	s := &Scraper{tableConfigs: map[jolokia.Table]jolokia.TableConfig{
+		lcs:  {Table: lcs, CompactionStrategy: "LeveledCompactionStrategy"},
+		twcs: {Table: twcs, CompactionStrategy: "TimeWindowCompactionStrategy"},
+		stcs: {Table: stcs, CompactionStrategy: "SizeTieredCompactionStrategy"},
+	}}
+
+	assert.True(t, s.hasSSTableLayout(lcs))
+	assert.True(t, s.hasSSTableLayout(twcs))
+	assert.False(t, s.hasSSTableLayout(stcs))
+	assert.True(t, s.hasSSTableLayout(unknown))
+}